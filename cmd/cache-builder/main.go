@@ -11,6 +11,7 @@ import (
 	"github.com/google/go-github/v82/github"
 	ghclient "github.com/johnsaigle/go-unmaintained/pkg/github"
 	"github.com/johnsaigle/go-unmaintained/pkg/popular"
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
 	"golang.org/x/oauth2"
 )
 
@@ -246,23 +247,20 @@ func analyzeRepository(ctx context.Context, ghClient *ghclient.Client, owner, re
 		}
 	}
 
+	lastUpdated, _ := repoInfo.LatestActivity(types.DefaultActivitySignals)
+
 	// Determine status
 	var status popular.Status
 	if !repoInfo.Exists {
 		status = popular.StatusNotFound
 	} else if repoInfo.IsArchived {
 		status = popular.StatusArchived
-	} else if !repoInfo.IsRepositoryActive(time.Duration(maxAge) * 24 * time.Hour) {
+	} else if time.Since(lastUpdated) > time.Duration(maxAge)*24*time.Hour {
 		status = popular.StatusInactive
 	} else {
 		status = popular.StatusActive
 	}
 
-	lastUpdated := repoInfo.UpdatedAt
-	if repoInfo.LastCommitAt != nil && repoInfo.LastCommitAt.After(repoInfo.UpdatedAt) {
-		lastUpdated = *repoInfo.LastCommitAt
-	}
-
 	return popular.Entry{
 		Package:      fmt.Sprintf("github.com/%s/%s", owner, repoName),
 		Owner:        owner,
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/johnsaigle/go-unmaintained/pkg/analyzer"
+	"github.com/johnsaigle/go-unmaintained/pkg/fixer"
+	"github.com/johnsaigle/go-unmaintained/pkg/parser"
+	"github.com/johnsaigle/go-unmaintained/pkg/resolver"
+)
+
+var (
+	fixApply bool
+
+	fixCmd = &cobra.Command{
+		Use:   "fix",
+		Short: "Migrate go.mod away from unmaintained dependencies",
+		Long: `fix analyzes the project like the root command, then rewrites its go.mod:
+
+  - flagged direct dependencies are replaced by their suggested successor module
+  - direct dependencies are upgraded when their latest version no longer requires a
+    flagged indirect dependency, which is then dropped
+
+By default the changes are shown as a diff. Nothing is written without --apply, and
+only go.mod is ever written: imports to update are listed as manual steps.`,
+		Example: `  # Show the changes as a diff
+  PAT=ghp_xxxx go-unmaintained fix --check-outdated
+
+  # Write them to go.mod
+  PAT=ghp_xxxx go-unmaintained fix --check-outdated --apply
+
+  # Use a module proxy laid out in a local directory
+  go-unmaintained fix --goproxy file:///srv/goproxy`,
+		Args: cobra.NoArgs,
+		RunE: runFix,
+	}
+)
+
+func init() {
+	fixCmd.Flags().BoolVar(&fixApply, "apply", false, "Write the changes to go.mod instead of showing a diff")
+	rootCmd.AddCommand(fixCmd)
+}
+
+func runFix(cmd *cobra.Command, args []string) error {
+	if err := prepareAnalysis(); err != nil {
+		return err
+	}
+
+	mod, err := parser.ParseGoMod(targetPath)
+	if err != nil {
+		return fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+	goModPath := filepath.Join(targetPath, "go.mod")
+	data, err := os.ReadFile(goModPath) //nolint:gosec // G304: go.mod of the target project
+	if err != nil {
+		return fmt.Errorf("failed to read go.mod: %w", err)
+	}
+
+	analyze, err := analyzer.NewAnalyzer(projectConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create analyzer: %w", err)
+	}
+
+	ctx := context.Background()
+	results, err := analyze.AnalyzeModule(ctx, mod)
+	if err != nil {
+		return fmt.Errorf("analysis failed: %w", err)
+	}
+
+	moduleResolver := resolver.NewResolver(time.Duration(resolverTimeout) * time.Second)
+	moduleResolver.SetProxyURL(goproxy)
+	plan, err := fixer.New(moduleResolver).Plan(ctx, goModPath, data, results)
+	if err != nil {
+		return fmt.Errorf("failed to plan fixes: %w", err)
+	}
+
+	w := cmd.OutOrStdout()
+	if len(plan.Changes) == 0 && len(plan.Manual) == 0 {
+		fmt.Fprintln(w, "✅ No fixes available for the dependencies found")
+		return nil
+	}
+
+	for _, change := range plan.Changes {
+		fmt.Fprintf(w, "🔧 %s\n", change)
+	}
+	for _, step := range plan.Manual {
+		fmt.Fprintf(w, "✋ %s\n", step)
+	}
+	if !plan.HasChanges() {
+		return nil
+	}
+
+	if !fixApply {
+		fmt.Fprintf(w, "\n%s\nDry run: re-run with --apply to write %s\n", plan.Diff(), goModPath)
+		return nil
+	}
+	if err := plan.Apply(goModPath); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "\nUpdated %s. Run go mod tidy, then update the imports listed above.\n", goModPath)
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,7 +12,11 @@ import (
 
 	"github.com/johnsaigle/go-unmaintained/pkg/analyzer"
 	"github.com/johnsaigle/go-unmaintained/pkg/formatter"
+	"github.com/johnsaigle/go-unmaintained/pkg/github"
 	"github.com/johnsaigle/go-unmaintained/pkg/parser"
+	"github.com/johnsaigle/go-unmaintained/pkg/providers"
+	"github.com/johnsaigle/go-unmaintained/pkg/resolver"
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
 )
 
 var (
@@ -20,6 +25,10 @@ var (
 	packageName     string
 	token           string
 	maxAge          int
+	maxReleaseAge   int
+	maxResponseTime int
+	responseSample  int
+	busFactorMonths int
 	outputFormat    string
 	jsonOutput      bool // Deprecated: use --format=json
 	githubActions   bool // Deprecated: use --format=github-actions
@@ -31,11 +40,36 @@ var (
 	noWarnings      bool
 	noExitCode      bool
 	checkOutdated   bool
+	checkLicenses   bool
+	checkGoMod      bool
+	rollup          bool
 	cacheDurationHr int
 	resolveUnknown  bool
 	resolverTimeout int
+	goproxy         string
+	vulnDB          string
+	scorecardPath   string
+	minScorecard    float64
+	minMaintained   float64
 	syncMode        bool
 	concurrency     int
+	activitySignals []string
+	botPatterns     []string
+	noticePatterns  []string
+
+	bitbucketServerURL   string
+	bitbucketServerToken string
+	githubEnterprise     []string
+	githubAppID          int64
+	githubAppInstall     int64
+	githubAppKeyPath     string
+	execProviderSpecs    []string
+
+	// Resolved from the flags above in runAnalysis
+	githubEnterpriseHosts []github.EnterpriseHost
+	githubApp             *github.AppCredentials
+	execProviders         []providers.Provider
+	activitySources       []types.ActivitySignal
 
 	rootCmd = &cobra.Command{
 		Use:   "go-unmaintained",
@@ -45,9 +79,12 @@ using heuristics.
 
 It analyzes go.mod files and their dependencies to detect packages that may pose 
 security or reliability risks due to lack of maintenance.`,
-		Example: `  # Scan current directory (requires PAT environment variable)
+		Example: `  # Scan current directory
   PAT=ghp_xxxx go-unmaintained
 
+  # Scan without a token, using only public data (popular packages, module proxy)
+  go-unmaintained --verbose
+
   # Scan a specific project directory
   PAT=ghp_xxxx go-unmaintained --target /path/to/project
 
@@ -72,42 +109,62 @@ func Execute() error {
 
 func init() {
 	// Target and input flags
-	rootCmd.Flags().StringVar(&targetPath, "target", ".", "Path to Go project directory")
-	rootCmd.Flags().StringVarP(&packageName, "package", "p", "", "Analyze single package instead of project")
+	rootCmd.PersistentFlags().StringVar(&targetPath, "target", ".", "Path to Go project directory")
+	rootCmd.PersistentFlags().StringVarP(&packageName, "package", "p", "", "Analyze single package instead of project")
 
 	// Authentication
-	rootCmd.Flags().StringVar(&token, "token", "", "GitHub token (can also use PAT env var)")
+	rootCmd.PersistentFlags().StringVar(&token, "token", "", "GitHub token (can also use PAT env var)")
+	rootCmd.PersistentFlags().Int64Var(&githubAppID, "github-app-id", 0, "GitHub App ID, to authenticate as an App installation instead of a token (can also use GITHUB_APP_ID env var)")
+	rootCmd.PersistentFlags().Int64Var(&githubAppInstall, "github-app-installation-id", 0, "GitHub App installation ID (can also use GITHUB_APP_INSTALLATION_ID env var)")
+	rootCmd.PersistentFlags().StringVar(&githubAppKeyPath, "github-app-private-key", "", "Path to the GitHub App private key PEM file (can also use GITHUB_APP_PRIVATE_KEY_PATH env var)")
+
+	// Self-hosted providers
+	rootCmd.PersistentFlags().StringArrayVar(&githubEnterprise, "github-enterprise", nil, "GitHub Enterprise Server host, optionally with API URL (host or host=https://host/api/v3/); repeatable. Token is read from PAT_<HOST> or GH_ENTERPRISE_TOKEN")
+	rootCmd.PersistentFlags().StringVar(&bitbucketServerURL, "bitbucket-server-url", "", "Base URL of a Bitbucket Server/Data Center instance (e.g. https://bitbucket.corp.example)")
+	rootCmd.PersistentFlags().StringVar(&bitbucketServerToken, "bitbucket-server-token", "", "Bitbucket Server HTTP access token (can also use BITBUCKET_SERVER_TOKEN env var)")
+	rootCmd.PersistentFlags().StringArrayVar(&execProviderSpecs, "exec-provider", nil, "External command that reports repository info for a host (host=command args...); repeatable. The command reads {host, owner, repo} JSON on stdin and prints RepoInfo JSON")
 
 	// Analysis configuration
-	rootCmd.Flags().IntVar(&maxAge, "max-age", 365, "Age in days that a repository must not exceed to be considered current")
-	rootCmd.Flags().BoolVar(&checkOutdated, "check-outdated", false, "Check if dependencies are using outdated versions")
-	rootCmd.Flags().BoolVar(&resolveUnknown, "resolve-unknown", false, "Try to resolve and check status of non-GitHub dependencies")
-	rootCmd.Flags().IntVar(&resolverTimeout, "resolver-timeout", 10, "Timeout in seconds for resolving non-GitHub dependencies")
+	rootCmd.PersistentFlags().IntVar(&maxAge, "max-age", 365, "Age in days that a repository must not exceed to be considered current")
+	rootCmd.PersistentFlags().IntVar(&maxReleaseAge, "max-release-age", 0, "Flag modules whose latest release is older than this many days (0 disables)")
+	rootCmd.PersistentFlags().IntVar(&maxResponseTime, "max-response-time", 0, "Flag GitHub repositories whose maintainers take longer than this many days (median) to respond to issues and PRs (0 disables; requires a token)")
+	rootCmd.PersistentFlags().IntVar(&responseSample, "response-sample", github.DefaultResponsivenessSample, "Number of recent issues and PRs sampled by --max-response-time")
+	rootCmd.PersistentFlags().IntVar(&busFactorMonths, "bus-factor-months", 0, "Count committers active in this many months and flag GitHub repositories whose sole maintainer has gone quiet for that long (0 disables; requires a token)")
+	rootCmd.PersistentFlags().StringSliceVar(&activitySignals, "activity-signals", []string{"last_commit", "pushed_at"}, "Timestamps that count as repository activity: last_commit (default branch), last_human_commit (ignoring bots), pushed_at, updated_at")
+	rootCmd.PersistentFlags().StringSliceVar(&botPatterns, "bot-patterns", github.DefaultBotPatterns, "Commit authors containing any of these (case-insensitive) are bots; used for the last_human_commit activity signal")
+	rootCmd.PersistentFlags().StringArrayVar(&noticePatterns, "notice-patterns", nil, "Regular expression (case-insensitive, repeatable) for maintenance notices in repository descriptions, topics and README headers; replaces the built-in patterns, and an empty pattern disables the check")
+	rootCmd.PersistentFlags().BoolVar(&checkOutdated, "check-outdated", false, "Check if dependencies are using outdated versions")
+	rootCmd.PersistentFlags().BoolVar(&checkGoMod, "check-gomod", false, "Read the go.mod of each dependency's latest version and report its go directive, missing module support and unmaintained requirements as a rot score")
+	rootCmd.PersistentFlags().BoolVar(&rollup, "rollup", false, "Count the unmaintained modules each direct dependency pulls in through the module graph (runs go mod graph)")
+	rootCmd.PersistentFlags().BoolVar(&checkLicenses, "check-licenses", false, "Report dependencies without a license, or whose license changed since the version in use (the comparison requires a token)")
+	rootCmd.PersistentFlags().BoolVar(&resolveUnknown, "resolve-unknown", false, "Try to resolve and check status of non-GitHub dependencies")
+	rootCmd.PersistentFlags().IntVar(&resolverTimeout, "resolver-timeout", 10, "Timeout in seconds for resolving non-GitHub dependencies")
+	rootCmd.PersistentFlags().StringVar(&vulnDB, "vulndb", "", "Directory holding a copy of the Go vulnerability database (OSV layout of vuln.go.dev) to report known vulnerabilities (default: GOVULNDB if it is a file:// URL)")
+	rootCmd.PersistentFlags().StringVar(&scorecardPath, "scorecard", "", "File or directory of OpenSSF Scorecard JSON results (scorecard --format json) to attach to dependencies")
+	rootCmd.PersistentFlags().Float64Var(&minScorecard, "min-scorecard-score", 0, "Flag dependencies whose aggregate Scorecard score is below this (0-10; 0 disables; requires --scorecard)")
+	rootCmd.PersistentFlags().Float64Var(&minMaintained, "min-maintained-score", 0, "Flag dependencies whose Scorecard Maintained check scores below this (0-10; 0 disables; requires --scorecard)")
+	rootCmd.PersistentFlags().StringVar(&goproxy, "goproxy", "", "Go module proxy URL; file:// URLs read a proxy laid out in a local directory (default: first proxy in GOPROXY, else https://proxy.golang.org)")
 
 	// Output options
-	rootCmd.Flags().StringVar(&outputFormat, "format", "console", "Output format: console, json, github-actions, golangci-lint")
-	rootCmd.Flags().BoolVar(&githubActions, "github-actions", false, "Output GitHub Actions annotations format (deprecated: use --format=github-actions)")
-	rootCmd.Flags().BoolVar(&verbose, "verbose", false, "Show detailed information")
-	rootCmd.Flags().BoolVar(&tree, "tree", false, "Show dependency tree paths")
-	rootCmd.Flags().StringVar(&colorOutput, "color", "auto", "When to use color: always, auto, or never")
-	rootCmd.Flags().BoolVar(&noWarnings, "no-warnings", false, "Do not show warnings")
-	rootCmd.Flags().BoolVar(&noExitCode, "no-exit-code", false, "Do not set exit code when unmaintained packages are found")
+	rootCmd.PersistentFlags().StringVar(&outputFormat, "format", "console", "Output format: console, json, github-actions, golangci-lint")
+	rootCmd.PersistentFlags().BoolVar(&githubActions, "github-actions", false, "Output GitHub Actions annotations format (deprecated: use --format=github-actions)")
+	rootCmd.PersistentFlags().BoolVar(&verbose, "verbose", false, "Show detailed information")
+	rootCmd.PersistentFlags().BoolVar(&tree, "tree", false, "Show dependency tree paths")
+	rootCmd.PersistentFlags().StringVar(&colorOutput, "color", "auto", "When to use color: always, auto, or never")
+	rootCmd.PersistentFlags().BoolVar(&noWarnings, "no-warnings", false, "Do not show warnings")
+	rootCmd.PersistentFlags().BoolVar(&noExitCode, "no-exit-code", false, "Do not set exit code when unmaintained packages are found")
 
 	// Performance and caching
-	rootCmd.Flags().BoolVar(&noCache, "no-cache", false, "Do not cache data on disk")
-	rootCmd.Flags().IntVar(&cacheDurationHr, "cache-duration", 24, "Cache duration in hours")
-	rootCmd.Flags().BoolVar(&failFast, "fail-fast", false, "Exit as soon as an unmaintained package is found")
-	rootCmd.Flags().BoolVar(&syncMode, "sync", false, "Disable async mode and use sequential processing (slower)")
-	rootCmd.Flags().IntVar(&concurrency, "concurrency", 5, "Number of concurrent requests (default: 5)")
+	rootCmd.PersistentFlags().BoolVar(&noCache, "no-cache", false, "Do not cache data on disk")
+	rootCmd.PersistentFlags().IntVar(&cacheDurationHr, "cache-duration", 24, "Cache duration in hours")
+	rootCmd.PersistentFlags().BoolVar(&failFast, "fail-fast", false, "Exit as soon as an unmaintained package is found")
+	rootCmd.PersistentFlags().BoolVar(&syncMode, "sync", false, "Disable async mode and use sequential processing (slower)")
+	rootCmd.PersistentFlags().IntVar(&concurrency, "concurrency", 5, "Number of concurrent requests (default: 5)")
 }
 
 func runAnalysis(cmd *cobra.Command, args []string) error {
-	// Get GitHub token from environment if not provided
-	if token == "" {
-		token = os.Getenv("PAT")
-		if token == "" {
-			return fmt.Errorf("GitHub token is required. Set PAT environment variable or use --token flag")
-		}
+	if err := prepareAnalysis(); err != nil {
+		return err
 	}
 
 	// Handle single package analysis
@@ -119,6 +176,57 @@ func runAnalysis(cmd *cobra.Command, args []string) error {
 	return analyzeProject(targetPath)
 }
 
+// prepareAnalysis resolves credentials, providers and activity signals from the flags and
+// environment, for every command that runs an analysis
+func prepareAnalysis() error {
+	app, err := loadGitHubApp()
+	if err != nil {
+		return err
+	}
+	githubApp = app
+
+	// Get GitHub token from environment if not provided (not needed when authenticating as an App)
+	if token == "" && githubApp == nil {
+		token = os.Getenv("PAT")
+		if token == "" && !noWarnings {
+			fmt.Fprintln(os.Stderr, "Warning: no GitHub token configured; using public data only (popular package data, the Go module proxy")
+			fmt.Fprintln(os.Stderr, "and unauthenticated GitHub requests, limited to 60 per hour). Each result lists the signals it is based on.")
+			fmt.Fprintln(os.Stderr, "Set PAT, use --token, or configure GitHub App credentials for full analysis.")
+		}
+	}
+
+	if bitbucketServerToken == "" {
+		bitbucketServerToken = os.Getenv("BITBUCKET_SERVER_TOKEN")
+	}
+
+	enterpriseHosts, err := parseGitHubEnterpriseHosts(githubEnterprise)
+	if err != nil {
+		return err
+	}
+	githubEnterpriseHosts = enterpriseHosts
+
+	configuredProviders, err := parseExecProviders(execProviderSpecs)
+	if err != nil {
+		return err
+	}
+	execProviders = configuredProviders
+
+	sources, err := parseActivitySignals(activitySignals)
+	if err != nil {
+		return err
+	}
+	activitySources = sources
+
+	if goproxy == "" {
+		goproxy = resolver.ProxyFromEnv()
+	}
+	// Only a local copy of the database is read; remote GOVULNDB URLs are ignored
+	if govulndb := os.Getenv("GOVULNDB"); vulnDB == "" && strings.HasPrefix(govulndb, "file://") {
+		vulnDB = govulndb
+	}
+	return nil
+}
+
 func analyzeProject(projectPath string) error {
 	// Parse go.mod file
 	mod, err := parser.ParseGoMod(projectPath)
@@ -149,20 +257,7 @@ func analyzeProject(projectPath string) error {
 	}
 
 	// Create analyzer
-	config := analyzer.Config{
-		MaxAge:          time.Duration(maxAge) * 24 * time.Hour,
-		Token:           token,
-		Verbose:         verbose,
-		CheckOutdated:   checkOutdated,
-		NoCache:         noCache,
-		CacheDuration:   time.Duration(cacheDurationHr) * time.Hour,
-		ResolveUnknown:  resolveUnknown,
-		ResolverTimeout: time.Duration(resolverTimeout) * time.Second,
-		AsyncMode:       !syncMode,
-		Concurrency:     concurrency,
-	}
-
-	analyze, err := analyzer.NewAnalyzer(config)
+	analyze, err := analyzer.NewAnalyzer(projectConfig())
 	if err != nil {
 		return fmt.Errorf("failed to create analyzer: %w", err)
 	}
@@ -213,6 +308,146 @@ func analyzeProject(projectPath string) error {
 	return nil
 }
 
+// projectConfig returns the analyzer configuration for a project scan from the flags
+func projectConfig() analyzer.Config {
+	return analyzer.Config{
+		MaxAge:               time.Duration(maxAge) * 24 * time.Hour,
+		MaxReleaseAge:        time.Duration(maxReleaseAge) * 24 * time.Hour,
+		MaxResponseTime:      time.Duration(maxResponseTime) * 24 * time.Hour,
+		ResponsivenessSample: responseSample,
+		BusFactorWindow:      time.Duration(busFactorMonths) * 30 * 24 * time.Hour,
+		Token:                token,
+		BitbucketServerURL:   bitbucketServerURL,
+		BitbucketServerToken: bitbucketServerToken,
+		ProxyURL:             goproxy,
+		VulnDBPath:           vulnDB,
+		ScorecardPath:        scorecardPath,
+		MinScorecardScore:    minScorecard,
+		MinMaintainedScore:   minMaintained,
+		GitHubEnterprise:     githubEnterpriseHosts,
+		GitHubApp:            githubApp,
+		Providers:            execProviders,
+		ActivitySignals:      activitySources,
+		BotPatterns:          botPatterns,
+		NoticePatterns:       noticePatterns,
+		Verbose:              verbose,
+		CompareForks:         verbose || determineFormat() == "json",
+		CheckOutdated:        checkOutdated,
+		CheckLicenses:        checkLicenses,
+		CheckGoMod:           checkGoMod,
+		Rollup:               rollup,
+		NoCache:              noCache,
+		CacheDuration:        time.Duration(cacheDurationHr) * time.Hour,
+		ResolveUnknown:       resolveUnknown,
+		ResolverTimeout:      time.Duration(resolverTimeout) * time.Second,
+		AsyncMode:            !syncMode,
+		Concurrency:          concurrency,
+	}
+}
+
+// loadGitHubApp returns GitHub App credentials from flags or environment variables,
+// or nil if no App is configured
+func loadGitHubApp() (*github.AppCredentials, error) {
+	if githubAppID == 0 {
+		githubAppID, _ = strconv.ParseInt(os.Getenv("GITHUB_APP_ID"), 10, 64)
+	}
+	if githubAppInstall == 0 {
+		githubAppInstall, _ = strconv.ParseInt(os.Getenv("GITHUB_APP_INSTALLATION_ID"), 10, 64)
+	}
+	if githubAppKeyPath == "" {
+		githubAppKeyPath = os.Getenv("GITHUB_APP_PRIVATE_KEY_PATH")
+	}
+
+	if githubAppID == 0 && githubAppInstall == 0 && githubAppKeyPath == "" {
+		return nil, nil
+	}
+	if githubAppID == 0 || githubAppInstall == 0 || githubAppKeyPath == "" {
+		return nil, fmt.Errorf("GitHub App authentication requires --github-app-id, --github-app-installation-id and --github-app-private-key")
+	}
+
+	privateKey, err := os.ReadFile(githubAppKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read GitHub App private key: %w", err)
+	}
+
+	return &github.AppCredentials{
+		AppID:          githubAppID,
+		InstallationID: githubAppInstall,
+		PrivateKey:     privateKey,
+	}, nil
+}
+
+// parseGitHubEnterpriseHosts parses --github-enterprise values of the form host or host=apiURL.
+// Each host's token comes from PAT_<HOST> (upper-cased, non-alphanumerics replaced by _),
+// falling back to GH_ENTERPRISE_TOKEN.
+func parseGitHubEnterpriseHosts(specs []string) ([]github.EnterpriseHost, error) {
+	hosts := make([]github.EnterpriseHost, 0, len(specs))
+	for _, spec := range specs {
+		host, apiURL, _ := strings.Cut(spec, "=")
+		host = strings.TrimSpace(host)
+		if host == "" {
+			return nil, fmt.Errorf("invalid --github-enterprise value %q: host is required", spec)
+		}
+
+		token := os.Getenv(enterpriseTokenEnvVar(host))
+		if token == "" {
+			token = os.Getenv("GH_ENTERPRISE_TOKEN")
+		}
+		if token == "" {
+			return nil, fmt.Errorf("GitHub token for %s is required. Set %s or GH_ENTERPRISE_TOKEN", host, enterpriseTokenEnvVar(host))
+		}
+
+		hosts = append(hosts, github.EnterpriseHost{
+			Host:   host,
+			APIURL: strings.TrimSpace(apiURL),
+			Token:  token,
+		})
+	}
+	return hosts, nil
+}
+
+// parseExecProviders parses --exec-provider values of the form host=command args...
+func parseExecProviders(specs []string) ([]providers.Provider, error) {
+	execProviders := make([]providers.Provider, 0, len(specs))
+	for _, spec := range specs {
+		host, command, ok := strings.Cut(spec, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --exec-provider value %q: expected host=command", spec)
+		}
+
+		provider, err := providers.NewExecProvider(strings.TrimSpace(host), strings.Fields(command))
+		if err != nil {
+			return nil, fmt.Errorf("invalid --exec-provider value %q: %w", spec, err)
+		}
+		execProviders = append(execProviders, provider)
+	}
+	return execProviders, nil
+}
+
+// parseActivitySignals validates the --activity-signals names
+func parseActivitySignals(names []string) ([]types.ActivitySignal, error) {
+	signals := make([]types.ActivitySignal, 0, len(names))
+	for _, name := range names {
+		signal, err := types.ParseActivitySignal(strings.TrimSpace(name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid --activity-signals: %w", err)
+		}
+		signals = append(signals, signal)
+	}
+	return signals, nil
+}
+
+// enterpriseTokenEnvVar returns the environment variable holding the token for a GitHub Enterprise host
+func enterpriseTokenEnvVar(host string) string {
+	name := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, host)
+	return "PAT_" + strings.ToUpper(name)
+}
+
 // determineFormat returns the output format based on flags
 // Handles legacy flags for backwards compatibility
 func determineFormat() string {
@@ -238,18 +473,39 @@ func analyzeSinglePackage(pkg string) error {
 
 	// Create analyzer config
 	config := analyzer.Config{
-		Token:           token,
-		MaxAge:          time.Duration(maxAge) * 24 * time.Hour,
-		CacheDuration:   time.Duration(cacheDurationHr) * time.Hour,
-		ResolverTimeout: time.Duration(resolverTimeout) * time.Second,
-		Concurrency:     concurrency,
-		Verbose:         verbose,
-		CheckOutdated:   checkOutdated,
-		NoCache:         noCache,
-		ResolveUnknown:  resolveUnknown,
-		AsyncMode:       !syncMode,
-		ShowProgress:    false,
-		ShowDepPath:     tree,
+		Token:                token,
+		BitbucketServerURL:   bitbucketServerURL,
+		BitbucketServerToken: bitbucketServerToken,
+		ProxyURL:             goproxy,
+		VulnDBPath:           vulnDB,
+		ScorecardPath:        scorecardPath,
+		MinScorecardScore:    minScorecard,
+		MinMaintainedScore:   minMaintained,
+		GitHubEnterprise:     githubEnterpriseHosts,
+		GitHubApp:            githubApp,
+		Providers:            execProviders,
+		ActivitySignals:      activitySources,
+		BotPatterns:          botPatterns,
+		NoticePatterns:       noticePatterns,
+		MaxAge:               time.Duration(maxAge) * 24 * time.Hour,
+		MaxReleaseAge:        time.Duration(maxReleaseAge) * 24 * time.Hour,
+		MaxResponseTime:      time.Duration(maxResponseTime) * 24 * time.Hour,
+		ResponsivenessSample: responseSample,
+		BusFactorWindow:      time.Duration(busFactorMonths) * 30 * 24 * time.Hour,
+		CacheDuration:        time.Duration(cacheDurationHr) * time.Hour,
+		ResolverTimeout:      time.Duration(resolverTimeout) * time.Second,
+		Concurrency:          concurrency,
+		Verbose:              verbose,
+		CompareForks:         verbose || determineFormat() == "json",
+		CheckOutdated:        checkOutdated,
+		CheckLicenses:        checkLicenses,
+		CheckGoMod:           checkGoMod,
+		Rollup:               rollup,
+		NoCache:              noCache,
+		ResolveUnknown:       resolveUnknown,
+		AsyncMode:            !syncMode,
+		ShowProgress:         false,
+		ShowDepPath:          tree,
 	}
 
 	// Create analyzer
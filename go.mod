@@ -5,7 +5,6 @@ go 1.25.4
 require (
 	github.com/google/go-github/v82 v82.0.0
 	golang.org/x/mod v0.33.0
-	golang.org/x/text v0.34.0
 )
 
 require (
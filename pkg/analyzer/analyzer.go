@@ -3,6 +3,9 @@ package analyzer
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -11,23 +14,50 @@ import (
 	"github.com/johnsaigle/go-unmaintained/pkg/parser"
 	"github.com/johnsaigle/go-unmaintained/pkg/popular"
 	"github.com/johnsaigle/go-unmaintained/pkg/providers"
+	"github.com/johnsaigle/go-unmaintained/pkg/replacements"
 	"github.com/johnsaigle/go-unmaintained/pkg/resolver"
+	"github.com/johnsaigle/go-unmaintained/pkg/scorecard"
 	"github.com/johnsaigle/go-unmaintained/pkg/types"
+	"github.com/johnsaigle/go-unmaintained/pkg/vulndb"
+	"golang.org/x/mod/module"
 	"golang.org/x/mod/semver"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 // UnmaintainedReason represents why a package is considered unmaintained
 type UnmaintainedReason string
 
 const (
-	ReasonArchived      UnmaintainedReason = "repository_archived"
-	ReasonNotFound      UnmaintainedReason = "package_not_found"
-	ReasonStaleInactive UnmaintainedReason = "stale_dependencies_inactive_repo"
-	ReasonOutdated      UnmaintainedReason = "outdated_version"
-	ReasonUnknown       UnmaintainedReason = "unknown_source"
-	ReasonActive        UnmaintainedReason = "active_maintained"
+	ReasonArchived        UnmaintainedReason = "repository_archived"
+	ReasonNotFound        UnmaintainedReason = "package_not_found"
+	ReasonMoved           UnmaintainedReason = "repository_moved"
+	ReasonStaleInactive   UnmaintainedReason = "stale_dependencies_inactive_repo"
+	ReasonOutdated        UnmaintainedReason = "outdated_version"
+	ReasonSuperseded      UnmaintainedReason = "superseded_by_major_version"
+	ReasonDeprecated      UnmaintainedReason = "module_deprecated"
+	ReasonNoRecentRelease UnmaintainedReason = "no_recent_release"
+	ReasonUnresponsive    UnmaintainedReason = "unresponsive_maintainers"
+	ReasonSoleMaintainer  UnmaintainedReason = "sole_maintainer_inactive"
+	// ReasonSelfDeclaredUnmaintained is a repository whose description, topics or README
+	// say it is unmaintained, though it is not archived
+	ReasonSelfDeclaredUnmaintained UnmaintainedReason = "self_declared_unmaintained"
+	// ReasonLowScorecard is an OpenSSF Scorecard result below the configured minimums
+	ReasonLowScorecard UnmaintainedReason = "low_scorecard_score"
+	ReasonUnknown      UnmaintainedReason = "unknown_source"
+	ReasonActive       UnmaintainedReason = "active_maintained"
+)
+
+// Signal names a source of data that contributed to a Result
+type Signal string
+
+const (
+	SignalPopularData     Signal = "popular_data"
+	SignalTrustedList     Signal = "trusted_modules"
+	SignalGitHubAPI       Signal = "github_api"
+	SignalGitHubAnonymous Signal = "github_api_unauthenticated"
+	SignalProviderAPI     Signal = "provider_api"
+	SignalResolver        Signal = "module_resolver"
+	SignalModuleProxy     Signal = "module_proxy"
+	SignalIssueActivity   Signal = "issue_responsiveness"
 )
 
 // indexedDep represents a dependency with its index for concurrent processing
@@ -38,51 +68,200 @@ type indexedDep struct {
 
 // Result represents the analysis result for a single dependency
 type Result struct {
-	DependencyPath   []string
-	RepoInfo         *types.RepoInfo
+	DependencyPath []string
+	Signals        []Signal
+	RepoInfo       *types.RepoInfo
+	// Submodule is set for a module nested in a repository subdirectory; activity and
+	// release heuristics then apply to it rather than to the whole repository
+	Submodule        *types.ModuleActivity
+	Responsiveness   *types.Responsiveness
 	Package          string
 	Reason           UnmaintainedReason
 	Details          string
 	CurrentVersion   string
 	LatestVersion    string
 	RetractionReason string
-	DaysSinceUpdate  int
-	IsUnmaintained   bool
-	IsDirect         bool
-	IsRetracted      bool
+	MovedTo          string
+	MovedModule      string
+	// SupersededBy is a later major version of the module path, e.g. example.com/mod/v3,
+	// and SupersededVersion its latest release
+	SupersededBy      string
+	SupersededVersion string
+	// HealthierRepository is the fork parent to switch to when it is active and the fork is not
+	HealthierRepository string
+	// MaintenanceNotice is the text declaring the repository unmaintained, and NoticeSource
+	// where it was found
+	MaintenanceNotice string
+	NoticeSource      NoticeSource
+	// Suggestion is what to move to from an unmaintained dependency, and SuggestionSource
+	// where it came from
+	Suggestion       string
+	SuggestionSource SuggestionSource
+	// HealthScore rates the dependency's maintenance from 0 to 100; nil without repository
+	// data, as for popular-cache and resolver-only results. See applyHealthScore.
+	HealthScore *int
+	// Subtree holds the unmaintained modules a direct dependency pulls in, set with Rollup
+	Subtree *SubtreeRot
+	// ModHygiene describes the go.mod of the latest version, set with CheckGoMod
+	ModHygiene *ModHygiene
+	// Scorecard is the repository's OpenSSF Scorecard result, from the configured results
+	Scorecard *scorecard.Score
+	// PinnedLicense is the repository's SPDX license at CurrentVersion, and LicenseAlert a
+	// license problem found with CheckLicenses
+	PinnedLicense string
+	LicenseAlert  LicenseAlert
+	// Vulnerabilities are the advisories affecting CurrentVersion, from the configured
+	// vulnerability database
+	Vulnerabilities []vulndb.Vulnerability
+	ActivitySource  types.ActivitySignal
+	DaysSinceUpdate int
+	// RepoDaysSinceUpdate is the repository's activity when DaysSinceUpdate measures a Submodule
+	RepoDaysSinceUpdate int
+	DaysSinceRelease    int
+	ReleasesBehind      int
+	DaysBehind          int
+	ActiveCommitters    int
+	IsUnmaintained bool
+	IsDirect       bool
+	IsRetracted    bool
 }
 
+// SuggestionSource identifies where a suggested replacement came from
+type SuggestionSource string
+
+const (
+	SuggestionCurated      SuggestionSource = "curated"
+	SuggestionDeprecation  SuggestionSource = "deprecation_notice"
+	SuggestionDescription  SuggestionSource = "repository_description"
+	SuggestionNotice       SuggestionSource = "maintenance_notice"
+	SuggestionMoved        SuggestionSource = "repository_moved"
+	SuggestionMajorVersion SuggestionSource = "major_version"
+	SuggestionForkParent   SuggestionSource = "fork_parent"
+)
+
 // Config holds configuration for the analyzer
 type Config struct {
-	Token           string
-	MaxAge          time.Duration
-	CacheDuration   time.Duration
-	ResolverTimeout time.Duration
-	Concurrency     int
-	Verbose         bool
-	CheckOutdated   bool
-	NoCache         bool
-	ResolveUnknown  bool
-	AsyncMode       bool
-	ShowProgress    bool
-	ShowDepPath     bool
+	Token                string
+	BitbucketServerURL   string
+	BitbucketServerToken string
+	// ProxyURL is the module proxy to query; empty means the public proxy
+	ProxyURL string
+	// VulnDBPath is a local copy of the Go vulnerability database; empty disables the check
+	VulnDBPath string
+	// ScorecardPath is a file or directory of OpenSSF Scorecard JSON results; empty disables
+	// Scorecard data. MinScorecardScore and MinMaintainedScore flag active dependencies scoring
+	// lower, overall or on the Maintained check; 0 disables them.
+	ScorecardPath      string
+	MinScorecardScore  float64
+	MinMaintainedScore float64
+	GitHubEnterprise   []github.EnterpriseHost
+	GitHubApp          *github.AppCredentials
+	Providers          []providers.Provider
+	ActivitySignals    []types.ActivitySignal
+	BotPatterns        []string
+	// NoticePatterns are regular expressions for maintenance notices; nil uses DefaultNoticePatterns
+	NoticePatterns       []string
+	MaxAge               time.Duration
+	MaxReleaseAge        time.Duration
+	MaxResponseTime      time.Duration
+	ResponsivenessSample int
+	BusFactorWindow      time.Duration
+	CacheDuration        time.Duration
+	ResolverTimeout      time.Duration
+	Concurrency          int
+	Verbose              bool
+	CheckOutdated        bool
+	CheckLicenses        bool
+	CheckGoMod           bool
+	Rollup               bool
+	NoCache              bool
+	ResolveUnknown       bool
+	AsyncMode            bool
+	ShowProgress         bool
+	ShowDepPath          bool
+	// CompareForks counts how far forks have diverged from their parent, for output that
+	// shows it (verbose console and JSON)
+	CompareForks bool
 }
 
 // Analyzer performs unmaintained package analysis
 type Analyzer struct {
-	githubClient  *github.Client
-	cache         *cache.Cache
-	resolver      *resolver.Resolver
-	multiProvider *providers.MultiProvider
-	config        Config
+	githubClient      *github.Client
+	enterpriseClients map[string]*github.Client
+	cache             *cache.Cache
+	resolver          *resolver.Resolver
+	multiProvider     *providers.MultiProvider
+	noticePatterns    []*regexp.Regexp
+	vulnDB            *vulndb.DB
+	scorecards        *scorecard.Store
+	config            Config
 }
 
 // NewAnalyzer creates a new analyzer instance
 func NewAnalyzer(config Config) (*Analyzer, error) {
-	githubClient, err := github.NewClient(config.Token)
+	var githubClient *github.Client
+	var err error
+	switch {
+	case config.GitHubApp != nil:
+		githubClient, err = github.NewAppClient(*config.GitHubApp)
+	case config.Token != "":
+		githubClient, err = github.NewClient(config.Token)
+	default:
+		// Token-optional mode: public data only, see applyProxySignals
+		githubClient = github.NewAnonymousClient()
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to create GitHub client: %w", err)
 	}
+	if config.BotPatterns != nil {
+		githubClient.SetBotPatterns(config.BotPatterns)
+	}
+	// Searching for the last human commit costs several requests per repository
+	humanCommits := slices.Contains(config.ActivitySignals, types.ActivityLastHumanCommit)
+	githubClient.SetHumanCommits(humanCommits)
+
+	var noticePatterns []*regexp.Regexp
+	if config.NoticePatterns != nil {
+		noticePatterns, err = compileNoticePatterns(config.NoticePatterns)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// The README is only needed to look for maintenance notices
+	readme := config.NoticePatterns == nil || len(noticePatterns) > 0
+	githubClient.SetReadme(readme)
+	githubClient.SetForkComparison(config.CompareForks)
+
+	var vulnDB *vulndb.DB
+	if config.VulnDBPath != "" {
+		vulnDB, err = vulndb.Open(config.VulnDBPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var scorecards *scorecard.Store
+	if config.ScorecardPath != "" {
+		scorecards, err = scorecard.Load(config.ScorecardPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	enterpriseClients := make(map[string]*github.Client, len(config.GitHubEnterprise))
+	for _, enterprise := range config.GitHubEnterprise {
+		client, err := github.NewEnterpriseClient(enterprise)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create GitHub Enterprise client: %w", err)
+		}
+		if config.BotPatterns != nil {
+			client.SetBotPatterns(config.BotPatterns)
+		}
+		client.SetHumanCommits(humanCommits)
+		client.SetReadme(readme)
+		client.SetForkComparison(config.CompareForks)
+		enterpriseClients[enterprise.Host] = client
+	}
 
 	// Initialize cache
 	cacheInstance, err := cache.NewCache(config.NoCache, config.CacheDuration)
@@ -103,25 +282,52 @@ func NewAnalyzer(config Config) (*Analyzer, error) {
 		resolverTimeout = 5 * time.Second // Shorter timeout for auto-resolution
 	}
 	moduleResolver := resolver.NewResolver(resolverTimeout)
+	if config.ProxyURL != "" {
+		moduleResolver.SetProxyURL(config.ProxyURL)
+	}
 
-	// Initialize multi-provider for GitLab, Bitbucket, etc.
+	// Initialize multi-provider for GitLab, Bitbucket, SourceHut, etc.
 	multiProvider := providers.NewMultiProvider()
+	if config.BitbucketServerURL != "" {
+		bitbucketServer, err := providers.NewBitbucketServerProvider(config.BitbucketServerURL, config.BitbucketServerToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Bitbucket Server provider: %w", err)
+		}
+		multiProvider.AddProvider(bitbucketServer)
+	}
+	for _, provider := range config.Providers {
+		multiProvider.AddProvider(provider)
+	}
 
 	return &Analyzer{
-		config:        config,
-		githubClient:  githubClient,
-		cache:         cacheInstance,
-		resolver:      moduleResolver,
-		multiProvider: multiProvider,
+		config:            config,
+		githubClient:      githubClient,
+		enterpriseClients: enterpriseClients,
+		cache:             cacheInstance,
+		resolver:          moduleResolver,
+		multiProvider:     multiProvider,
+		noticePatterns:    noticePatterns,
+		vulnDB:            vulnDB,
+		scorecards:        scorecards,
 	}, nil
 }
 
 // AnalyzeModule analyzes all dependencies in a module
 func (a *Analyzer) AnalyzeModule(ctx context.Context, mod *parser.Module) ([]Result, error) {
+	var results []Result
+	var err error
 	if a.config.AsyncMode {
-		return a.analyzeModuleConcurrent(ctx, mod)
+		results, err = a.analyzeModuleConcurrent(ctx, mod)
+	} else {
+		results, err = a.analyzeModuleSequential(ctx, mod)
+	}
+	if err == nil && a.config.CheckGoMod {
+		rescoreModHygiene(results)
 	}
-	return a.analyzeModuleSequential(ctx, mod)
+	if err == nil && a.config.Rollup {
+		a.applyRollup(ctx, mod, results)
+	}
+	return results, err
 }
 
 // analyzeModuleSequential processes dependencies one by one (original behavior)
@@ -164,11 +370,11 @@ func (a *Analyzer) analyzeModuleConcurrent(ctx context.Context, mod *parser.Modu
 	var cachedDeps, githubDeps, otherDeps []indexedDep
 
 	for i, dep := range mod.Dependencies {
-		moduleInfo := parser.ParseModulePath(dep.Path)
+		moduleInfo := a.parseModulePath(dep.Path)
 		if !a.config.NoCache {
 			// Check if this dependency is likely cached
 			if moduleInfo.IsGitHub {
-				_, _, cacheHit := a.cache.GetRepoInfo(moduleInfo.Owner, moduleInfo.Repo)
+				_, _, cacheHit := a.cache.GetRepoInfoForHost(moduleInfo.Host, moduleInfo.Owner, moduleInfo.Repo)
 				if cacheHit {
 					cachedDeps = append(cachedDeps, indexedDep{dep, i})
 					continue
@@ -255,6 +461,94 @@ func maxInt(a, b int) int {
 
 // AnalyzeDependency analyzes a single dependency by delegating to specialized methods.
 func (a *Analyzer) AnalyzeDependency(ctx context.Context, dep parser.Dependency) (Result, error) {
+	result, err := a.analyzeDependency(ctx, dep)
+	if err != nil || dep.Replace != nil {
+		return result, err
+	}
+
+	// Without a token the module proxy stands in for most of the GitHub data
+	if a.isTokenless() {
+		a.applyProxySignals(ctx, &result, dep)
+	}
+	if a.config.CheckOutdated {
+		a.applyVersionLag(ctx, &result, dep)
+		a.applyMajorVersionCheck(ctx, &result, dep)
+	}
+	a.applyScorecard(&result, dep)
+	a.applyModHygiene(ctx, &result, dep)
+	a.applySuggestion(ctx, &result, dep)
+	a.applyVulnerabilities(&result, dep)
+	if a.config.CheckLicenses {
+		applyLicenseCheck(&result)
+	}
+	a.applyHealthScore(&result)
+	return result, nil
+}
+
+// applyVulnerabilities records the advisories affecting the version in use. Read errors
+// leave the result without vulnerability data, like other optional refinements.
+func (a *Analyzer) applyVulnerabilities(result *Result, dep parser.Dependency) {
+	if a.vulnDB == nil || dep.Version == "" {
+		return
+	}
+	if vulns, err := a.vulnDB.Lookup(dep.Path, dep.Version); err == nil {
+		result.Vulnerabilities = vulns
+	}
+}
+
+// HasUnfixedVulnerability reports whether an advisory affects the version in use and no
+// release fixes it, so upgrading is no way out
+func (r *Result) HasUnfixedVulnerability() bool {
+	return slices.ContainsFunc(r.Vulnerabilities, func(v vulndb.Vulnerability) bool {
+		return !v.Fixed()
+	})
+}
+
+// applySuggestion picks a replacement for an unmaintained dependency. The curated mapping
+// comes first, then the successor named by the module's deprecation notice, its archived
+// repository's description or its maintenance notice, then what the other checks found: a
+// new location, a later major version or an active fork parent.
+func (a *Analyzer) applySuggestion(ctx context.Context, result *Result, dep parser.Dependency) {
+	if !result.IsUnmaintained || result.Suggestion != "" {
+		return
+	}
+
+	suggest := func(suggestion string, source SuggestionSource) bool {
+		if suggestion == "" || suggestion == dep.Path {
+			return false
+		}
+		result.Suggestion = suggestion
+		result.SuggestionSource = source
+		return true
+	}
+
+	if replacement, ok := replacements.Lookup(dep.Path); ok && suggest(replacement.String(), SuggestionCurated) {
+		return
+	}
+	if a.resolver != nil {
+		if latest, err := a.resolver.GetLatestModule(ctx, dep.Path); err == nil && suggest(replacements.ParseSuccessor(latest.Deprecated), SuggestionDeprecation) {
+			return
+		}
+	}
+	if repoInfo := result.RepoInfo; repoInfo != nil && repoInfo.IsArchived && suggest(replacements.ParseSuccessor(repoInfo.Description), SuggestionDescription) {
+		return
+	}
+	if result.Reason == ReasonSelfDeclaredUnmaintained && suggest(replacements.ParseSuccessor(result.MaintenanceNotice), SuggestionNotice) {
+		return
+	}
+
+	movedTo := result.MovedModule
+	if movedTo == "" {
+		movedTo = result.MovedTo
+	}
+	if suggest(movedTo, SuggestionMoved) || suggest(result.SupersededBy, SuggestionMajorVersion) {
+		return
+	}
+	suggest(result.HealthierRepository, SuggestionForkParent)
+}
+
+// analyzeDependency routes a dependency to the analysis for its hosting provider.
+func (a *Analyzer) analyzeDependency(ctx context.Context, dep parser.Dependency) (Result, error) {
 	result := a.initResult(dep)
 
 	// Skip replaced dependencies
@@ -268,7 +562,7 @@ func (a *Analyzer) AnalyzeDependency(ctx context.Context, dep parser.Dependency)
 		return a.resultFromPopularEntry(entry, dep), nil
 	}
 
-	moduleInfo := parser.ParseModulePath(dep.Path)
+	moduleInfo := a.parseModulePath(dep.Path)
 	if !moduleInfo.IsValid {
 		result.Details = "Invalid module path format"
 		return result, nil
@@ -282,6 +576,38 @@ func (a *Analyzer) AnalyzeDependency(ctx context.Context, dep parser.Dependency)
 	return a.analyzeGitHub(ctx, dep, moduleInfo)
 }
 
+// parseModulePath parses a module path, treating configured GitHub Enterprise hosts as GitHub.
+func (a *Analyzer) parseModulePath(path string) *parser.ModuleInfo {
+	moduleInfo := parser.ParseModulePath(path)
+	if _, ok := a.enterpriseClients[moduleInfo.Host]; ok && moduleInfo.IsValid {
+		moduleInfo.IsGitHub = true
+		moduleInfo.IsKnownHost = true
+		moduleInfo.Subdir = parser.ModuleSubdir(path)
+	}
+	return moduleInfo
+}
+
+// githubClientFor returns the GitHub client for a host, or nil if the host is not configured.
+func (a *Analyzer) githubClientFor(host string) *github.Client {
+	if host == "" || host == github.DefaultHost {
+		return a.githubClient
+	}
+	return a.enterpriseClients[host]
+}
+
+// isTokenless reports whether the analyzer runs without GitHub credentials.
+func (a *Analyzer) isTokenless() bool {
+	return a.githubClient != nil && a.githubClient.IsAnonymous()
+}
+
+// githubSignal returns the signal recorded for data fetched through client.
+func githubSignal(client *github.Client) Signal {
+	if client.IsAnonymous() {
+		return SignalGitHubAnonymous
+	}
+	return SignalGitHubAPI
+}
+
 // initResult creates an initial Result with basic fields populated.
 func (a *Analyzer) initResult(dep parser.Dependency) Result {
 	return Result{
@@ -305,11 +631,12 @@ func (a *Analyzer) analyzeNonGitHub(ctx context.Context, dep parser.Dependency,
 	if parser.IsTrustedGoModule(dep.Path) {
 		result.Reason = ReasonActive
 		result.Details = getTrustedModuleStatus(dep.Path)
+		result.Signals = []Signal{SignalTrustedList}
 		return result, nil
 	}
 
-	// Check if it's a supported hosting provider (GitLab, Bitbucket)
-	if moduleInfo.IsKnownHost && (moduleInfo.Host == "gitlab.com" || moduleInfo.Host == "bitbucket.org") {
+	// Check if it's a supported hosting provider (GitLab, Bitbucket, SourceHut, or a configured self-hosted instance)
+	if a.multiProvider.GetProvider(moduleInfo.Host) != nil {
 		return a.analyzeThirdPartyProvider(ctx, dep, moduleInfo)
 	}
 
@@ -327,10 +654,12 @@ func (a *Analyzer) analyzeGitHubMapping(ctx context.Context, dep parser.Dependen
 		return result, nil
 	}
 
+	result.Signals = []Signal{githubSignal(a.githubClient)}
+	a.attachReleaseInfo(ctx, dep.Path, repoInfo, a.githubClient, owner, repo)
 	return a.applyRepoHeuristics(result, repoInfo, "Go extended package")
 }
 
-// analyzeThirdPartyProvider handles GitLab and Bitbucket repositories.
+// analyzeThirdPartyProvider handles repositories on non-GitHub providers (GitLab, Bitbucket, SourceHut, etc.).
 func (a *Analyzer) analyzeThirdPartyProvider(ctx context.Context, dep parser.Dependency, moduleInfo *parser.ModuleInfo) (Result, error) {
 	result := a.initResult(dep)
 
@@ -340,10 +669,10 @@ func (a *Analyzer) analyzeThirdPartyProvider(ctx context.Context, dep parser.Dep
 		return result, nil
 	}
 
-	caser := cases.Title(language.English)
-	hostName := caser.String(strings.Split(moduleInfo.Host, ".")[0])
-
-	return a.applyRepoHeuristics(result, repoInfo, hostName)
+	result.Signals = []Signal{SignalProviderAPI}
+	a.detectMove(ctx, &result, moduleInfo, repoInfo, nil)
+	a.attachReleaseInfo(ctx, dep.Path, repoInfo, nil, "", "")
+	return a.applyRepoHeuristics(result, repoInfo, a.multiProvider.GetProvider(moduleInfo.Host).GetName())
 }
 
 // analyzeViaResolver attempts to resolve unknown modules using the resolver.
@@ -356,6 +685,11 @@ func (a *Analyzer) analyzeViaResolver(ctx context.Context, dep parser.Dependency
 		return result, nil
 	}
 
+	// Vanity paths that point at a supported host (e.g. go.googlesource.com) get a real repository check
+	if vanityResult, ok := a.analyzeVanityImport(ctx, dep); ok {
+		return vanityResult, nil
+	}
+
 	resolved := a.resolver.ResolveModule(ctx, dep.Path)
 	if resolved == nil {
 		result.Details = fmt.Sprintf("Could not resolve non-GitHub dependency (%s)", moduleInfo.Host)
@@ -363,9 +697,47 @@ func (a *Analyzer) analyzeViaResolver(ctx context.Context, dep parser.Dependency
 	}
 
 	a.applyResolvedStatus(&result, resolved)
+	result.Signals = []Signal{SignalResolver}
 	return result, nil
 }
 
+// analyzeVanityImport follows a module's go-import meta tag and, when the repository
+// is hosted on a supported provider, applies the standard repository heuristics.
+// Returns false if the repository could not be checked so the caller can fall back.
+func (a *Analyzer) analyzeVanityImport(ctx context.Context, dep parser.Dependency) (Result, bool) {
+	meta, err := a.resolver.ResolveImport(ctx, dep.Path)
+	if err != nil || meta.VCS != "git" {
+		return Result{}, false
+	}
+
+	repoURL, err := url.Parse(meta.RepoRoot)
+	if err != nil {
+		return Result{}, false
+	}
+
+	provider := a.multiProvider.GetProvider(repoURL.Host)
+	if provider == nil {
+		return Result{}, false
+	}
+
+	repoPath := strings.Trim(strings.TrimSuffix(repoURL.Path, ".git"), "/")
+	owner, repo := "", repoPath
+	if idx := strings.LastIndex(repoPath, "/"); idx != -1 {
+		owner, repo = repoPath[:idx], repoPath[idx+1:]
+	}
+
+	repoInfo, err := a.multiProvider.GetRepositoryInfo(ctx, repoURL.Host, owner, repo)
+	if err != nil {
+		return Result{}, false
+	}
+
+	result := a.initResult(dep)
+	result.Signals = []Signal{SignalProviderAPI}
+	a.attachReleaseInfo(ctx, dep.Path, repoInfo, nil, "", "")
+	result, _ = a.applyRepoHeuristics(result, repoInfo, provider.GetName())
+	return result, true
+}
+
 func (a *Analyzer) unresolvedDetails(moduleInfo *parser.ModuleInfo) string {
 	if moduleInfo.IsKnownHost {
 		return fmt.Sprintf("Non-GitHub dependency (%s) - status unknown", moduleInfo.Host)
@@ -397,46 +769,121 @@ func (a *Analyzer) analyzeGitHub(ctx context.Context, dep parser.Dependency, mod
 	owner := moduleInfo.Owner
 	repo := moduleInfo.Repo
 
-	repoInfo, latestVersion, err := a.fetchRepoWithCache(ctx, owner, repo)
+	repoInfo, err := a.fetchRepoWithCache(ctx, moduleInfo.Host, owner, repo)
 	if err != nil {
+		if a.isTokenless() {
+			// Typically the unauthenticated rate limit; the module proxy fills in below
+			result.Reason = ReasonUnknown
+			result.Details = fmt.Sprintf("GitHub data unavailable without a token: %v", err)
+			return result, nil
+		}
 		return result, err
 	}
 
+	client := a.githubClientFor(moduleInfo.Host)
+	if client != nil {
+		result.Signals = []Signal{githubSignal(client)}
+	}
+	a.detectMove(ctx, &result, moduleInfo, repoInfo, client)
+	if moduleInfo.Subdir != "" {
+		a.attachSubmodule(ctx, &result, dep.Path, moduleInfo.Subdir, repoInfo, client, owner, repo)
+	} else {
+		a.attachReleaseInfo(ctx, dep.Path, repoInfo, client, owner, repo)
+	}
+	a.attachResponsiveness(ctx, &result, repoInfo, client, owner, repo)
+	a.attachContributors(ctx, repoInfo, client, owner, repo)
+	a.attachPinnedLicense(ctx, &result, dep, moduleInfo.Subdir, repoInfo, client, owner, repo)
 	result.RepoInfo = repoInfo
-	result.DaysSinceUpdate = repoInfo.DaysSinceLastActivity()
-	result.LatestVersion = latestVersion
 
-	return a.applyHeuristics(result, dep)
+	result, err = a.applyHeuristics(result)
+	if err == nil {
+		a.compareWithParent(ctx, &result, moduleInfo.Host)
+	}
+	return result, err
 }
 
-// fetchRepoWithCache fetches repository info, using cache if available.
-func (a *Analyzer) fetchRepoWithCache(ctx context.Context, owner, repo string) (*types.RepoInfo, string, error) {
-	cachedInfo, cachedVersion, cacheHit := a.cache.GetRepoInfo(owner, repo)
-	if cacheHit {
-		return cachedInfo, cachedVersion, nil
+// compareWithParent notes when a fork and its parent differ in health: an unmaintained fork
+// of an active parent suggests switching to the parent, while a maintained fork of an archived
+// or inactive parent is the healthier of the two.
+func (a *Analyzer) compareWithParent(ctx context.Context, result *Result, host string) {
+	repoInfo := result.RepoInfo
+	if repoInfo == nil || !repoInfo.IsFork || repoInfo.Parent == nil {
+		return
+	}
+
+	parent := repoInfo.Parent
+	parentActive, known := a.parentActive(ctx, host, parent)
+	if !known {
+		return
 	}
 
-	repoInfo, err := a.githubClient.GetRepositoryInfo(ctx, owner, repo)
-	if err != nil {
-		return nil, "", fmt.Errorf("failed to get repository info: %w", err)
+	switch {
+	case result.IsUnmaintained && parentActive:
+		result.HealthierRepository = host + "/" + parent.FullName
+		result.Details += fmt.Sprintf("; fork of %s, which is active", result.HealthierRepository)
+	case !result.IsUnmaintained && !parentActive:
+		result.Details += fmt.Sprintf("; fork of %s/%s, which is no longer maintained, so this fork is the healthier repository", host, parent.FullName)
+	}
+}
+
+// parentActive judges a fork's parent the way the fork itself is judged: by its newest
+// activity under the configured activity signals, where last_human_commit leaves bot commits
+// out. If the parent's repository info cannot be fetched, its last push is used instead when
+// pushed_at is a configured signal. known is false when the parent's activity is unknown.
+func (a *Analyzer) parentActive(ctx context.Context, host string, parent *types.ForkParent) (active, known bool) {
+	if parent.IsArchived {
+		return false, true
+	}
+	if owner, repo, ok := strings.Cut(parent.FullName, "/"); ok {
+		if parentInfo, err := a.fetchRepoWithCache(ctx, host, owner, repo); err == nil {
+			if parentInfo.IsArchived {
+				return false, true
+			}
+			var parentResult Result
+			return a.recordActivity(&parentResult, parentInfo)
+		}
+	}
+	if parent.PushedAt == nil || !slices.Contains(a.activitySignals(), types.ActivityPushed) {
+		return false, false
+	}
+	return time.Since(*parent.PushedAt) <= a.config.MaxAge, true
+}
+
+// fetchRepoWithCache fetches repository info from the GitHub instance at host, using cache if available.
+func (a *Analyzer) fetchRepoWithCache(ctx context.Context, host, owner, repo string) (*types.RepoInfo, error) {
+	if cachedInfo, _, cacheHit := a.cache.GetRepoInfoForHost(host, owner, repo); cacheHit && !a.missingHumanCommit(cachedInfo) {
+		return cachedInfo, nil
 	}
 
-	latestVersion := ""
-	if a.config.CheckOutdated && repoInfo.Exists && !repoInfo.IsArchived {
-		latestVersion, _ = a.githubClient.GetLatestVersion(ctx, owner, repo)
+	client := a.githubClientFor(host)
+	if client == nil {
+		return nil, fmt.Errorf("no GitHub client configured for %s", host)
 	}
 
-	if err := a.cache.SetRepoInfo(owner, repo, repoInfo, latestVersion); err != nil {
+	repoInfo, err := client.GetRepositoryInfo(ctx, owner, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository info: %w", err)
+	}
+
+	// Latest versions come from the module proxy (see applyVersionLag), so none is cached here
+	if err := a.cache.SetRepoInfoForHost(host, owner, repo, repoInfo, ""); err != nil {
 		// Cache write errors are non-fatal; log and continue
 		_ = err
 	}
-	return repoInfo, latestVersion, nil
+	return repoInfo, nil
+}
+
+// missingHumanCommit reports whether repository info lacks the last human commit the
+// configured activity signals need, as when it was cached by a run that did not search for it
+func (a *Analyzer) missingHumanCommit(repoInfo *types.RepoInfo) bool {
+	return repoInfo.LastCommitAt != nil && repoInfo.LastHumanCommitAt == nil &&
+		slices.Contains(a.config.ActivitySignals, types.ActivityLastHumanCommit)
 }
 
 // applyRepoHeuristics applies standard heuristics to a repoInfo and returns the result.
 func (a *Analyzer) applyRepoHeuristics(result Result, repoInfo *types.RepoInfo, source string) (Result, error) {
 	result.RepoInfo = repoInfo
-	result.DaysSinceUpdate = repoInfo.DaysSinceLastActivity()
+	active, known := a.recordActivity(&result, repoInfo)
 
 	if !repoInfo.Exists {
 		result.IsUnmaintained = true
@@ -452,21 +899,322 @@ func (a *Analyzer) applyRepoHeuristics(result Result, repoInfo *types.RepoInfo,
 		return result, nil
 	}
 
-	if !repoInfo.IsRepositoryActive(a.config.MaxAge) {
+	if checkMoved(&result) {
+		return result, nil
+	}
+
+	if a.checkMaintenanceNotice(&result, repoInfo) {
+		return result, nil
+	}
+
+	if !known {
+		a.activityUnknown(&result, source+" repository")
+		return result, nil
+	}
+
+	if !active {
 		result.IsUnmaintained = true
 		result.Reason = ReasonStaleInactive
 		result.Details = fmt.Sprintf("%s repository inactive for %d days", source, result.DaysSinceUpdate)
 		return result, nil
 	}
 
+	if a.checkBusFactor(&result, repoInfo) {
+		return result, nil
+	}
+
+	if a.checkReleaseAge(&result, repoInfo) {
+		return result, nil
+	}
+
 	result.Reason = ReasonActive
 	result.Details = fmt.Sprintf("Active %s repository, last updated %d days ago", source, result.DaysSinceUpdate)
 	return result, nil
 }
 
+// detectMove records where a repository lives now if the host reports a different owner/repo
+// than requested, as it does after a rename or transfer. With a GitHub client, the module path
+// declared by the go.mod in the new repository is recorded too.
+func (a *Analyzer) detectMove(ctx context.Context, result *Result, moduleInfo *parser.ModuleInfo, repoInfo *types.RepoInfo, client *github.Client) {
+	requested := moduleInfo.Owner + "/" + moduleInfo.Repo
+	if !repoInfo.Exists || repoInfo.FullName == "" || strings.EqualFold(repoInfo.FullName, requested) {
+		return
+	}
+
+	result.MovedTo = moduleInfo.Host + "/" + repoInfo.FullName
+	if client == nil {
+		return
+	}
+
+	newOwner, newRepo, _ := strings.Cut(repoInfo.FullName, "/")
+	for _, dir := range goModDirs(result.Package, moduleInfo.Host+"/"+requested) {
+		if modulePath, err := client.GetModulePath(ctx, newOwner, newRepo, dir); err == nil {
+			result.MovedModule = modulePath
+			return
+		}
+	}
+}
+
+// goModDirs returns the directories, relative to the repository root, that may hold the
+// go.mod of modulePath: its own subdirectory and, for /vN paths, the directory without the
+// major version suffix (major branch layout)
+func goModDirs(modulePath, repoRoot string) []string {
+	relative := func(path string) string {
+		return strings.TrimPrefix(strings.TrimPrefix(path, repoRoot), "/")
+	}
+
+	dirs := []string{relative(modulePath)}
+	if prefix, _, ok := module.SplitPathVersion(modulePath); ok && prefix != modulePath {
+		dirs = append(dirs, relative(prefix))
+	}
+	return dirs
+}
+
+// checkMoved flags a dependency whose repository was renamed or transferred, unless the
+// new repository's go.mod still declares the module path in use. Returns true if flagged.
+func checkMoved(result *Result) bool {
+	if result.MovedTo == "" || result.MovedModule == result.Package {
+		return false
+	}
+
+	result.IsUnmaintained = true
+	result.Reason = ReasonMoved
+	result.Details = fmt.Sprintf("Repository moved to %s", result.MovedTo)
+	if result.MovedModule != "" {
+		result.Details += fmt.Sprintf("; its go.mod declares module %s", result.MovedModule)
+	}
+	return true
+}
+
+// attachReleaseInfo fills in the latest release of a repository when the release heuristic is
+// enabled. The module proxy is asked first since it costs no API quota and covers every host;
+// GitHub releases and tags are the fallback when a GitHub client is given.
+func (a *Analyzer) attachReleaseInfo(ctx context.Context, modulePath string, repoInfo *types.RepoInfo, client *github.Client, owner, repo string) {
+	if a.config.MaxReleaseAge <= 0 || !repoInfo.Exists || repoInfo.LatestReleaseAt != nil {
+		return
+	}
+
+	// A pseudo-version as @latest means the module has no tagged versions
+	if a.resolver != nil {
+		if latest, err := a.resolver.GetLatestInfo(ctx, modulePath); err == nil && !module.IsPseudoVersion(latest.Version) {
+			releasedAt := latest.Time
+			repoInfo.LatestRelease = latest.Version
+			repoInfo.LatestReleaseAt = &releasedAt
+			return
+		}
+	}
+
+	if client == nil || client.IsAnonymous() {
+		return
+	}
+	if tag, releasedAt, err := client.GetLatestRelease(ctx, owner, repo); err == nil && releasedAt != nil {
+		repoInfo.LatestRelease = tag
+		repoInfo.LatestReleaseAt = releasedAt
+	}
+}
+
+// attachSubmodule records the activity of a module nested in dir: commits touching dir and,
+// when the release heuristic is enabled, its latest dir/vX.Y.Z release. The module proxy
+// already maps those tags to the module's versions, so it is asked before GitHub tags.
+// Errors leave the module without data, so the repository's activity is used instead.
+func (a *Analyzer) attachSubmodule(ctx context.Context, result *Result, modulePath, dir string, repoInfo *types.RepoInfo, client *github.Client, owner, repo string) {
+	if client == nil || !repoInfo.Exists || repoInfo.IsArchived {
+		return
+	}
+
+	submodule, err := client.GetModuleActivity(ctx, owner, repo, repoInfo.DefaultBranch, dir)
+	if err != nil {
+		return
+	}
+	result.Submodule = submodule
+
+	if a.config.MaxReleaseAge <= 0 {
+		return
+	}
+	if a.resolver != nil {
+		if latest, infoErr := a.resolver.GetLatestInfo(ctx, modulePath); infoErr == nil && !module.IsPseudoVersion(latest.Version) {
+			releasedAt := latest.Time
+			submodule.LatestRelease = dir + "/" + latest.Version
+			submodule.LatestReleaseAt = &releasedAt
+			return
+		}
+	}
+	if client.IsAnonymous() {
+		return
+	}
+	if tag, releasedAt, tagErr := client.GetLatestModuleTag(ctx, owner, repo, dir); tagErr == nil && releasedAt != nil {
+		submodule.LatestRelease = tag
+		submodule.LatestReleaseAt = releasedAt
+	}
+}
+
+// checkReleaseAge flags a repository, or a nested module's own releases, whose latest release
+// is older than MaxReleaseAge. Modules that were never released are left to the other heuristics.
+func (a *Analyzer) checkReleaseAge(result *Result, repoInfo *types.RepoInfo) bool {
+	tag, releasedAt := repoInfo.LatestRelease, repoInfo.LatestReleaseAt
+	if result.Submodule != nil {
+		tag, releasedAt = result.Submodule.LatestRelease, result.Submodule.LatestReleaseAt
+	}
+	if a.config.MaxReleaseAge <= 0 || releasedAt == nil {
+		return false
+	}
+
+	sinceRelease := time.Since(*releasedAt)
+	result.DaysSinceRelease = int(sinceRelease.Hours() / 24)
+	if sinceRelease <= a.config.MaxReleaseAge {
+		return false
+	}
+
+	result.IsUnmaintained = true
+	result.Reason = ReasonNoRecentRelease
+	result.Details = fmt.Sprintf("No release for %d days (latest: %s)", result.DaysSinceRelease, tag)
+	return true
+}
+
+// minResponsivenessSample is the fewest sampled issues needed to judge maintainer responsiveness
+const minResponsivenessSample = 5
+
+// attachResponsiveness samples recent issues and pull requests when the responsiveness
+// heuristic is enabled. It needs an authenticated GitHub client, since every sampled item
+// with comments costs an API request. Errors are ignored, as the data is optional.
+func (a *Analyzer) attachResponsiveness(ctx context.Context, result *Result, repoInfo *types.RepoInfo, client *github.Client, owner, repo string) {
+	if a.config.MaxResponseTime <= 0 || client == nil || client.IsAnonymous() || !repoInfo.Exists || repoInfo.IsArchived {
+		return
+	}
+
+	sampleSize := a.config.ResponsivenessSample
+	if sampleSize <= 0 {
+		sampleSize = github.DefaultResponsivenessSample
+	}
+
+	responsiveness, err := client.GetResponsiveness(ctx, owner, repo, sampleSize)
+	if err != nil {
+		return
+	}
+	result.Responsiveness = responsiveness
+	result.Signals = append(result.Signals, SignalIssueActivity)
+}
+
+// checkResponsiveness flags a repository whose maintainers take longer than MaxResponseTime
+// (median) to respond to issues and pull requests. Returns true if the result was flagged.
+func (a *Analyzer) checkResponsiveness(result *Result) bool {
+	responsiveness := result.Responsiveness
+	if a.config.MaxResponseTime <= 0 || responsiveness == nil || responsiveness.Sampled < minResponsivenessSample {
+		return false
+	}
+	if responsiveness.MedianResponse <= a.config.MaxResponseTime {
+		return false
+	}
+
+	result.IsUnmaintained = true
+	result.Reason = ReasonUnresponsive
+	result.Details = fmt.Sprintf("Maintainers take a median of %d days to respond (%d of %d recent issues and PRs answered, %d open / %d closed)",
+		responsiveness.MedianResponseDays(), responsiveness.Responded, responsiveness.Sampled, responsiveness.Open, responsiveness.Closed)
+	return true
+}
+
+// soleMaintainerShare is the share of all commits above which the top contributor is
+// considered a repository's only maintainer
+const soleMaintainerShare = 0.8
+
+// attachContributors fetches contributor statistics when the bus-factor heuristic is
+// enabled and the repository info does not have them yet. Errors are ignored, since
+// GitHub may still be computing the statistics.
+func (a *Analyzer) attachContributors(ctx context.Context, repoInfo *types.RepoInfo, client *github.Client, owner, repo string) {
+	if a.config.BusFactorWindow <= 0 || client == nil || client.IsAnonymous() || !repoInfo.Exists || repoInfo.IsArchived || repoInfo.Contributors != nil {
+		return
+	}
+
+	if contributors, err := client.GetContributors(ctx, owner, repo); err == nil {
+		repoInfo.Contributors = contributors
+	}
+}
+
+// checkBusFactor counts the committers active within BusFactorWindow and flags a repository
+// whose sole maintainer has not committed in that window, with nobody else committing either;
+// an active committer means maintenance was handed over. Returns true if the result was flagged.
+func (a *Analyzer) checkBusFactor(result *Result, repoInfo *types.RepoInfo) bool {
+	if a.config.BusFactorWindow <= 0 {
+		return false
+	}
+	top, share, ok := repoInfo.TopContributor()
+	if !ok {
+		return false
+	}
+
+	since := time.Now().Add(-a.config.BusFactorWindow)
+	result.ActiveCommitters = repoInfo.ActiveCommitters(since)
+	if share < soleMaintainerShare || result.ActiveCommitters > 0 {
+		return false
+	}
+
+	result.IsUnmaintained = true
+	result.Reason = ReasonSoleMaintainer
+	result.Details = fmt.Sprintf("Sole maintainer %s (%.0f%% of commits) has not committed in %d days, and no one else has",
+		top.Login, share*100, int(a.config.BusFactorWindow.Hours()/24))
+	return true
+}
+
+// recordActivity sets DaysSinceUpdate and ActivitySource from the configured activity
+// signals and reports whether the repository counts as active. For a nested module with
+// commit data, the module's activity is measured and the repository's is kept in
+// RepoDaysSinceUpdate.
+func (a *Analyzer) recordActivity(result *Result, repoInfo *types.RepoInfo) (active, known bool) {
+	if !repoInfo.Exists {
+		result.DaysSinceUpdate = -1
+		return false, true
+	}
+
+	signals := a.activitySignals()
+	latestActivity, source := repoInfo.LatestActivity(signals)
+	result.ActivitySource = source
+	result.DaysSinceUpdate = int(time.Since(latestActivity).Hours() / 24)
+	if source == "" {
+		result.DaysSinceUpdate = -1
+	}
+
+	if result.Submodule != nil {
+		if moduleActivity, moduleSource, ok := result.Submodule.LatestActivity(signals); ok {
+			result.RepoDaysSinceUpdate = result.DaysSinceUpdate
+			result.ActivitySource = moduleSource
+			result.DaysSinceUpdate = int(time.Since(moduleActivity).Hours() / 24)
+			return time.Since(moduleActivity) <= a.config.MaxAge, true
+		}
+	}
+	if source == "" {
+		return false, false
+	}
+	return time.Since(latestActivity) <= a.config.MaxAge, true
+}
+
+// activitySignals returns the configured activity signals, or the defaults
+func (a *Analyzer) activitySignals() []types.ActivitySignal {
+	if len(a.config.ActivitySignals) == 0 {
+		return types.DefaultActivitySignals
+	}
+	return a.config.ActivitySignals
+}
+
+// activityUnknown marks a result whose repository reports none of the configured activity
+// signals. Other signals are not substituted: the user excluded them.
+func (a *Analyzer) activityUnknown(result *Result, source string) {
+	signals := make([]string, 0, len(a.activitySignals()))
+	for _, signal := range a.activitySignals() {
+		signals = append(signals, string(signal))
+	}
+	result.Reason = ReasonUnknown
+	result.Details = fmt.Sprintf("%s reports none of the configured activity signals (%s)", source, strings.Join(signals, ", "))
+}
+
+// isSubmoduleActivity reports whether DaysSinceUpdate measures a nested module
+func (r *Result) isSubmoduleActivity() bool {
+	return r.Submodule != nil && r.Submodule.LastCommitAt != nil
+}
+
 // applyHeuristics applies standard heuristics for GitHub repositories.
-func (a *Analyzer) applyHeuristics(result Result, dep parser.Dependency) (Result, error) {
+func (a *Analyzer) applyHeuristics(result Result) (Result, error) {
 	repoInfo := result.RepoInfo
+	active, known := a.recordActivity(&result, repoInfo)
 
 	if !repoInfo.Exists {
 		result.IsUnmaintained = true
@@ -482,49 +1230,159 @@ func (a *Analyzer) applyHeuristics(result Result, dep parser.Dependency) (Result
 		return result, nil
 	}
 
-	if !repoInfo.IsRepositoryActive(a.config.MaxAge) {
+	if checkMoved(&result) {
+		return result, nil
+	}
+
+	if a.checkMaintenanceNotice(&result, repoInfo) {
+		return result, nil
+	}
+
+	if !known {
+		a.activityUnknown(&result, "Repository")
+		return result, nil
+	}
+
+	if !active {
 		result.IsUnmaintained = true
 		result.Reason = ReasonStaleInactive
 		result.Details = fmt.Sprintf("Repository inactive for %d days", result.DaysSinceUpdate)
+		if result.isSubmoduleActivity() {
+			result.Details = fmt.Sprintf("Module directory %s/ inactive for %d days (repository last updated %d days ago)",
+				result.Submodule.Dir, result.DaysSinceUpdate, result.RepoDaysSinceUpdate)
+		}
 		return result, nil
 	}
 
-	if a.config.CheckOutdated && result.LatestVersion != "" && a.isVersionOutdated(dep.Version, result.LatestVersion) {
-		result.IsUnmaintained = true
-		result.Reason = ReasonOutdated
-		result.Details = fmt.Sprintf("Using outdated version %s (latest: %s)", dep.Version, result.LatestVersion)
+	if a.checkBusFactor(&result, repoInfo) {
+		return result, nil
+	}
+
+	if a.checkReleaseAge(&result, repoInfo) {
+		if result.Submodule != nil {
+			result.Details = fmt.Sprintf("Module directory %s/: %s", result.Submodule.Dir, result.Details)
+		}
+		return result, nil
+	}
+
+	if a.checkResponsiveness(&result) {
 		return result, nil
 	}
 
 	result.Reason = ReasonActive
 	result.Details = fmt.Sprintf("Active repository, last updated %d days ago", result.DaysSinceUpdate)
-	if result.LatestVersion != "" {
-		result.Details += fmt.Sprintf(" (version: %s, latest: %s)", dep.Version, result.LatestVersion)
+	if result.isSubmoduleActivity() {
+		result.Details = fmt.Sprintf("Active module directory %s/, last changed %d days ago (repository last updated %d days ago)",
+			result.Submodule.Dir, result.DaysSinceUpdate, result.RepoDaysSinceUpdate)
+	}
+	if human := repoInfo.LastHumanCommitAt; human != nil && time.Since(*human) > a.config.MaxAge {
+		// Active only because of bot commits such as dependency bumps
+		result.Details += fmt.Sprintf(", last human commit %d days ago", int(time.Since(*human).Hours()/24))
+	}
+	if result.MovedTo != "" {
+		// Renamed or transferred, but the new go.mod still declares the path in use
+		result.Details += fmt.Sprintf(", repository moved to %s", result.MovedTo)
 	}
 	return result, nil
 }
 
-// isVersionOutdated checks if the current version is outdated compared to the latest
-func (a *Analyzer) isVersionOutdated(current, latest string) bool {
-	if current == "" || latest == "" {
-		return false
+// applyVersionLag compares the version in use with the module proxy's version list for the
+// dependency's exact module path, and flags an otherwise active dependency that is behind.
+func (a *Analyzer) applyVersionLag(ctx context.Context, result *Result, dep parser.Dependency) {
+	if a.resolver == nil || dep.Version == "" {
+		return
 	}
 
-	// Normalize versions to ensure they start with 'v'
-	if !strings.HasPrefix(current, "v") {
-		current = "v" + current
+	lag, err := a.resolver.GetVersionLag(ctx, dep.Path, dep.Version)
+	if err != nil || lag.Latest == "" {
+		return
 	}
-	if !strings.HasPrefix(latest, "v") {
-		latest = "v" + latest
+	result.LatestVersion = lag.Latest
+	result.ReleasesBehind = lag.ReleasesBehind
+	result.DaysBehind = int(lag.AgeGap.Hours() / 24)
+
+	if lag.ReleasesBehind == 0 || result.Reason != ReasonActive {
+		return
 	}
+	result.IsUnmaintained = true
+	result.Reason = ReasonOutdated
+	result.Details = fmt.Sprintf("Using outdated version %s (latest: %s, %d releases and %d days behind)",
+		dep.Version, lag.Latest, lag.ReleasesBehind, result.DaysBehind)
+}
 
-	// Only compare if both are valid semantic versions
-	if !semver.IsValid(current) || !semver.IsValid(latest) {
-		return false
+// applyMajorVersionCheck records a later major version of the dependency's module path and
+// flags the dependency as superseded when the major version in use has had no release within
+// MaxAge. Stronger findings such as archival are kept.
+func (a *Analyzer) applyMajorVersionCheck(ctx context.Context, result *Result, dep parser.Dependency) {
+	if a.resolver == nil {
+		return
 	}
 
-	// Compare versions: return true if current is less than latest
-	return semver.Compare(current, latest) < 0
+	major, err := a.resolver.GetLatestMajorVersion(ctx, dep.Path)
+	if err != nil || major == nil {
+		return
+	}
+	result.SupersededBy = major.Path
+	result.SupersededVersion = major.Version
+
+	if result.IsUnmaintained && result.Reason != ReasonOutdated {
+		return
+	}
+	// Some modules keep releasing fixes for older major versions
+	if current, infoErr := a.resolver.GetLatestInfo(ctx, dep.Path); infoErr == nil && time.Since(current.Time) <= a.config.MaxAge {
+		return
+	}
+
+	result.IsUnmaintained = true
+	result.Reason = ReasonSuperseded
+	result.Details = fmt.Sprintf("Superseded by major version %s: %s (latest: %s)", semver.Major(major.Version), major.Path, major.Version)
+}
+
+// applyProxySignals adds the module proxy's view of a dependency: retractions, deprecation
+// notices and, when no repository data could classify it, how recently it was released.
+func (a *Analyzer) applyProxySignals(ctx context.Context, result *Result, dep parser.Dependency) {
+	if a.resolver == nil {
+		return
+	}
+
+	latest, err := a.resolver.GetLatestModule(ctx, dep.Path)
+	if err != nil {
+		return
+	}
+	result.Signals = append(result.Signals, SignalModuleProxy)
+
+	if retracted, reason := resolver.IsVersionRetracted(dep.Version, latest.Retractions); retracted {
+		result.IsRetracted = true
+		result.RetractionReason = reason
+	}
+
+	if latest.Deprecated != "" && !result.IsUnmaintained {
+		result.IsUnmaintained = true
+		result.Reason = ReasonDeprecated
+		result.Details = fmt.Sprintf("Module is deprecated: %s", latest.Deprecated)
+		return
+	}
+
+	// Release recency is only a fallback for dependencies nothing else could classify
+	if result.RepoInfo != nil || (result.Reason != "" && result.Reason != ReasonUnknown) {
+		return
+	}
+
+	result.DaysSinceUpdate = int(time.Since(latest.Time).Hours() / 24)
+	if time.Since(latest.Time) > a.config.MaxAge {
+		result.IsUnmaintained = true
+		result.Reason = ReasonStaleInactive
+		result.Details = fmt.Sprintf("No release for %d days (latest: %s, from module proxy)", result.DaysSinceUpdate, latest.Version)
+		return
+	}
+
+	result.Reason = ReasonActive
+	result.Details = fmt.Sprintf("Latest release %s published %d days ago (from module proxy)", latest.Version, result.DaysSinceUpdate)
+	if dep.Version != latest.Version {
+		if current, infoErr := a.resolver.GetVersionInfo(ctx, dep.Path, dep.Version); infoErr == nil {
+			result.Details += fmt.Sprintf(", %s published %d days ago", dep.Version, int(time.Since(current.Time).Hours()/24))
+		}
+	}
 }
 
 // SummaryStats holds summary statistics
@@ -535,10 +1393,24 @@ type SummaryStats struct {
 	IndirectUnmaintained int
 	ArchivedCount        int
 	NotFoundCount        int
+	MovedCount           int
 	StaleInactiveCount   int
 	OutdatedCount        int
+	SupersededCount      int
+	DeprecatedCount      int
+	NoRecentReleaseCount int
+	UnresponsiveCount    int
+	SoleMaintainerCount  int
+	SelfDeclaredCount    int
+	LowScorecardCount    int
 	UnknownCount         int
 	RetractedCount       int
+	// VulnerableCount counts dependencies with known vulnerabilities, and
+	// UnmaintainedVulnerableCount the unmaintained ones among them with no fix released
+	VulnerableCount             int
+	UnmaintainedVulnerableCount int
+	LicenseChangedCount         int
+	NoLicenseCount              int
 }
 
 // GetSummary returns summary statistics from results
@@ -563,10 +1435,26 @@ func GetSummary(results []Result) SummaryStats {
 				stats.ArchivedCount++
 			case ReasonNotFound:
 				stats.NotFoundCount++
+			case ReasonMoved:
+				stats.MovedCount++
 			case ReasonStaleInactive:
 				stats.StaleInactiveCount++
 			case ReasonOutdated:
 				stats.OutdatedCount++
+			case ReasonSuperseded:
+				stats.SupersededCount++
+			case ReasonDeprecated:
+				stats.DeprecatedCount++
+			case ReasonNoRecentRelease:
+				stats.NoRecentReleaseCount++
+			case ReasonUnresponsive:
+				stats.UnresponsiveCount++
+			case ReasonSoleMaintainer:
+				stats.SoleMaintainerCount++
+			case ReasonSelfDeclaredUnmaintained:
+				stats.SelfDeclaredCount++
+			case ReasonLowScorecard:
+				stats.LowScorecardCount++
 			}
 		} else if result.Reason == ReasonUnknown {
 			// Track unknown dependencies separately
@@ -577,6 +1465,20 @@ func GetSummary(results []Result) SummaryStats {
 		if result.IsRetracted {
 			stats.RetractedCount++
 		}
+
+		switch result.LicenseAlert {
+		case LicenseChanged:
+			stats.LicenseChangedCount++
+		case LicenseMissing:
+			stats.NoLicenseCount++
+		}
+
+		if len(result.Vulnerabilities) > 0 {
+			stats.VulnerableCount++
+			if result.IsUnmaintained && result.HasUnfixedVulnerability() {
+				stats.UnmaintainedVulnerableCount++
+			}
+		}
 	}
 
 	return stats
@@ -601,6 +1503,7 @@ func (a *Analyzer) resultFromPopularEntry(entry *popular.Entry, dep parser.Depen
 		CurrentVersion:  dep.Version,
 		IsDirect:        !dep.Indirect,
 		DaysSinceUpdate: daysSinceUpdate,
+		Signals:         []Signal{SignalPopularData},
 	}
 
 	cacheAgeInfo := ""
@@ -1,54 +1,35 @@
 package analyzer
 
 import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/johnsaigle/go-unmaintained/pkg/cache"
+	"github.com/johnsaigle/go-unmaintained/pkg/github"
 	"github.com/johnsaigle/go-unmaintained/pkg/parser"
 	"github.com/johnsaigle/go-unmaintained/pkg/popular"
+	"github.com/johnsaigle/go-unmaintained/pkg/resolver"
+	"github.com/johnsaigle/go-unmaintained/pkg/scorecard"
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
+	"github.com/johnsaigle/go-unmaintained/pkg/vulndb"
 )
 
-func TestIsVersionOutdated(t *testing.T) {
-	a := &Analyzer{}
-
-	tests := []struct {
-		name     string
-		current  string
-		latest   string
-		expected bool
-	}{
-		{"same version", "v1.2.3", "v1.2.3", false},
-		{"current is older", "v1.0.0", "v1.2.3", true},
-		{"current is newer", "v2.0.0", "v1.2.3", false},
-		{"patch behind", "v1.2.0", "v1.2.3", true},
-		{"without v prefix", "1.2.3", "1.2.3", false},
-		{"current without v, older", "1.0.0", "1.2.3", true},
-		{"empty current", "", "v1.2.3", false},
-		{"empty latest", "v1.2.3", "", false},
-		{"both empty", "", "", false},
-		{"invalid current", "notaversion", "v1.2.3", false},
-		{"invalid latest", "v1.2.3", "notaversion", false},
-		{"major version bump", "v1.9.9", "v2.0.0", true},
-		{"pre-release", "v1.2.3-alpha", "v1.2.3", true},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := a.isVersionOutdated(tt.current, tt.latest)
-			if result != tt.expected {
-				t.Errorf("isVersionOutdated(%q, %q) = %v, want %v",
-					tt.current, tt.latest, result, tt.expected)
-			}
-		})
-	}
-}
-
 func TestGetSummary(t *testing.T) {
 	results := []Result{
 		{IsUnmaintained: true, IsDirect: true, Reason: ReasonArchived},
 		{IsUnmaintained: true, IsDirect: true, Reason: ReasonNotFound},
 		{IsUnmaintained: true, IsDirect: false, Reason: ReasonStaleInactive},
 		{IsUnmaintained: true, IsDirect: false, Reason: ReasonOutdated},
+		{IsUnmaintained: true, IsDirect: false, Reason: ReasonNoRecentRelease},
 		{IsUnmaintained: false, Reason: ReasonActive},
 		{IsUnmaintained: false, Reason: ReasonUnknown},
 		{IsUnmaintained: false, IsRetracted: true, Reason: ReasonActive},
@@ -56,17 +37,17 @@ func TestGetSummary(t *testing.T) {
 
 	summary := GetSummary(results)
 
-	if summary.TotalDependencies != 7 {
-		t.Errorf("TotalDependencies = %d, want 7", summary.TotalDependencies)
+	if summary.TotalDependencies != 8 {
+		t.Errorf("TotalDependencies = %d, want 8", summary.TotalDependencies)
 	}
-	if summary.UnmaintainedCount != 4 {
-		t.Errorf("UnmaintainedCount = %d, want 4", summary.UnmaintainedCount)
+	if summary.UnmaintainedCount != 5 {
+		t.Errorf("UnmaintainedCount = %d, want 5", summary.UnmaintainedCount)
 	}
 	if summary.DirectUnmaintained != 2 {
 		t.Errorf("DirectUnmaintained = %d, want 2", summary.DirectUnmaintained)
 	}
-	if summary.IndirectUnmaintained != 2 {
-		t.Errorf("IndirectUnmaintained = %d, want 2", summary.IndirectUnmaintained)
+	if summary.IndirectUnmaintained != 3 {
+		t.Errorf("IndirectUnmaintained = %d, want 3", summary.IndirectUnmaintained)
 	}
 	if summary.ArchivedCount != 1 {
 		t.Errorf("ArchivedCount = %d, want 1", summary.ArchivedCount)
@@ -80,6 +61,9 @@ func TestGetSummary(t *testing.T) {
 	if summary.OutdatedCount != 1 {
 		t.Errorf("OutdatedCount = %d, want 1", summary.OutdatedCount)
 	}
+	if summary.NoRecentReleaseCount != 1 {
+		t.Errorf("NoRecentReleaseCount = %d, want 1", summary.NoRecentReleaseCount)
+	}
 	if summary.UnknownCount != 1 {
 		t.Errorf("UnknownCount = %d, want 1", summary.UnknownCount)
 	}
@@ -124,6 +108,25 @@ func TestGetTrustedModuleStatus(t *testing.T) {
 	}
 }
 
+func TestParseModulePath_GitHubEnterprise(t *testing.T) {
+	a := &Analyzer{enterpriseClients: map[string]*github.Client{"ghe.corp.example": {}}}
+
+	info := a.parseModulePath("ghe.corp.example/org/repo/sub")
+	if !info.IsGitHub || !info.IsKnownHost {
+		t.Errorf("configured enterprise host should be treated as GitHub, got %+v", info)
+	}
+	if info.Owner != "org" || info.Repo != "repo" {
+		t.Errorf("Owner/Repo = %q/%q, want org/repo", info.Owner, info.Repo)
+	}
+
+	if info := a.parseModulePath("other.corp.example/org/repo"); info.IsGitHub {
+		t.Error("unconfigured host should not be treated as GitHub")
+	}
+	if a.githubClientFor("other.corp.example") != nil {
+		t.Error("githubClientFor() should return nil for unconfigured hosts")
+	}
+}
+
 func TestMaxInt(t *testing.T) {
 	if maxInt(3, 5) != 5 {
 		t.Error("maxInt(3, 5) should be 5")
@@ -230,3 +233,981 @@ func TestResultFromPopularEntry(t *testing.T) {
 		})
 	}
 }
+
+// newTestProxyResolver returns a resolver backed by an httptest module proxy serving
+// the given path -> body map; unknown paths return 404
+func newTestProxyResolver(t *testing.T, files map[string]string) *resolver.Resolver {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	moduleResolver := resolver.NewResolver(5 * time.Second)
+	moduleResolver.SetProxyURL(server.URL)
+	return moduleResolver
+}
+
+func TestApplyProxySignals(t *testing.T) {
+	now := time.Now().UTC()
+	files := map[string]string{
+		"/example.com/fresh/@latest":          fmt.Sprintf(`{"Version":"v1.2.0","Time":%q}`, now.Add(-20*24*time.Hour).Format(time.RFC3339)),
+		"/example.com/fresh/@v/v1.2.0.mod":    "module example.com/fresh\n\nretract v1.0.0 // Broken\n",
+		"/example.com/fresh/@v/v1.0.0.info":   fmt.Sprintf(`{"Version":"v1.0.0","Time":%q}`, now.Add(-90*24*time.Hour).Format(time.RFC3339)),
+		"/example.com/old/@latest":            fmt.Sprintf(`{"Version":"v0.3.0","Time":%q}`, now.Add(-900*24*time.Hour).Format(time.RFC3339)),
+		"/example.com/old/@v/v0.3.0.mod":      "module example.com/old\n",
+		"/example.com/gone/@latest":           fmt.Sprintf(`{"Version":"v2.0.0","Time":%q}`, now.Format(time.RFC3339)),
+		"/example.com/gone/@v/v2.0.0.mod":     "// Deprecated: use example.com/next.\nmodule example.com/gone\n",
+		"/example.com/archived/@latest":       fmt.Sprintf(`{"Version":"v1.0.0","Time":%q}`, now.Format(time.RFC3339)),
+		"/example.com/archived/@v/v1.0.0.mod": "module example.com/archived\n",
+	}
+	a := &Analyzer{resolver: newTestProxyResolver(t, files), config: Config{MaxAge: 365 * 24 * time.Hour}}
+
+	tests := []struct {
+		name             string
+		result           Result
+		dep              parser.Dependency
+		wantReason       UnmaintainedReason
+		wantUnmaintained bool
+		wantRetracted    bool
+		wantProxySignal  bool
+	}{
+		{
+			name:            "recent release with retracted pinned version",
+			result:          Result{Reason: ReasonUnknown},
+			dep:             parser.Dependency{Path: "example.com/fresh", Version: "v1.0.0"},
+			wantReason:      ReasonActive,
+			wantRetracted:   true,
+			wantProxySignal: true,
+		},
+		{
+			name:             "no release within max age",
+			result:           Result{Reason: ReasonUnknown},
+			dep:              parser.Dependency{Path: "example.com/old", Version: "v0.3.0"},
+			wantReason:       ReasonStaleInactive,
+			wantUnmaintained: true,
+			wantProxySignal:  true,
+		},
+		{
+			name:             "deprecated module",
+			result:           Result{Reason: ReasonActive, Signals: []Signal{SignalGitHubAnonymous}},
+			dep:              parser.Dependency{Path: "example.com/gone", Version: "v2.0.0"},
+			wantReason:       ReasonDeprecated,
+			wantUnmaintained: true,
+			wantProxySignal:  true,
+		},
+		{
+			name:             "existing verdict is kept",
+			result:           Result{Reason: ReasonArchived, IsUnmaintained: true, Signals: []Signal{SignalPopularData}},
+			dep:              parser.Dependency{Path: "example.com/archived", Version: "v1.0.0"},
+			wantReason:       ReasonArchived,
+			wantUnmaintained: true,
+			wantProxySignal:  true,
+		},
+		{
+			name:   "module missing from proxy",
+			result: Result{Reason: ReasonUnknown},
+			dep:    parser.Dependency{Path: "example.com/private", Version: "v1.0.0"},
+			// Nothing to add, so the result stays unknown
+			wantReason: ReasonUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.result
+			a.applyProxySignals(context.Background(), &result, tt.dep)
+
+			if result.Reason != tt.wantReason {
+				t.Errorf("Reason = %v, want %v (details: %s)", result.Reason, tt.wantReason, result.Details)
+			}
+			if result.IsUnmaintained != tt.wantUnmaintained {
+				t.Errorf("IsUnmaintained = %v, want %v", result.IsUnmaintained, tt.wantUnmaintained)
+			}
+			if result.IsRetracted != tt.wantRetracted {
+				t.Errorf("IsRetracted = %v, want %v", result.IsRetracted, tt.wantRetracted)
+			}
+			if got := slices.Contains(result.Signals, SignalModuleProxy); got != tt.wantProxySignal {
+				t.Errorf("Signals = %v, want module proxy signal %v", result.Signals, tt.wantProxySignal)
+			}
+		})
+	}
+}
+
+func TestApplyRepoHeuristics_ActivitySignals(t *testing.T) {
+	now := time.Now()
+	committed := now.Add(-700 * 24 * time.Hour)
+	// A dead repository whose updated_at keeps moving because people star it
+	repoInfo := &types.RepoInfo{Exists: true, UpdatedAt: now.Add(-3 * 24 * time.Hour), LastCommitAt: &committed}
+
+	a := &Analyzer{config: Config{MaxAge: 365 * 24 * time.Hour}}
+	result, _ := a.applyRepoHeuristics(Result{}, repoInfo, "GitLab")
+	if result.Reason != ReasonStaleInactive {
+		t.Errorf("default signals: Reason = %v, want %v", result.Reason, ReasonStaleInactive)
+	}
+	if result.ActivitySource != types.ActivityLastCommit || result.DaysSinceUpdate != 700 {
+		t.Errorf("default signals: ActivitySource = %q, DaysSinceUpdate = %d; want last_commit, 700", result.ActivitySource, result.DaysSinceUpdate)
+	}
+
+	a.config.ActivitySignals = []types.ActivitySignal{types.ActivityLastCommit, types.ActivityUpdated}
+	result, _ = a.applyRepoHeuristics(Result{}, repoInfo, "GitLab")
+	if result.Reason != ReasonActive || result.ActivitySource != types.ActivityUpdated {
+		t.Errorf("with updated_at: Reason = %v, ActivitySource = %q; want active via updated_at", result.Reason, result.ActivitySource)
+	}
+
+	// Human commits were not fetched: the excluded signals must not stand in for them
+	a.config.ActivitySignals = []types.ActivitySignal{types.ActivityLastHumanCommit}
+	result, _ = a.applyRepoHeuristics(Result{}, repoInfo, "GitLab")
+	if result.IsUnmaintained || result.Reason != ReasonUnknown {
+		t.Errorf("signals unavailable: IsUnmaintained = %v, Reason = %v; want not flagged, %v", result.IsUnmaintained, result.Reason, ReasonUnknown)
+	}
+	if result.ActivitySource != "" || result.DaysSinceUpdate != -1 {
+		t.Errorf("signals unavailable: ActivitySource = %q, DaysSinceUpdate = %d; want unknown", result.ActivitySource, result.DaysSinceUpdate)
+	}
+}
+
+func TestReleaseAge(t *testing.T) {
+	now := time.Now().UTC()
+	a := &Analyzer{
+		resolver: newTestProxyResolver(t, map[string]string{
+			"/example.com/pinned/@latest":   fmt.Sprintf(`{"Version":"v1.4.0","Time":%q}`, now.Add(-1200*24*time.Hour).Format(time.RFC3339)),
+			"/example.com/recent/@latest":   fmt.Sprintf(`{"Version":"v0.9.0","Time":%q}`, now.Add(-30*24*time.Hour).Format(time.RFC3339)),
+			"/example.com/untagged/@latest": fmt.Sprintf(`{"Version":"v0.0.0-20200101000000-abcdefabcdef","Time":%q}`, now.Add(-2000*24*time.Hour).Format(time.RFC3339)),
+		}),
+		config: Config{MaxAge: 365 * 24 * time.Hour, MaxReleaseAge: 3 * 365 * 24 * time.Hour},
+	}
+
+	tests := []struct {
+		module      string
+		wantRelease string
+		wantReason  UnmaintainedReason
+	}{
+		{"example.com/pinned", "v1.4.0", ReasonNoRecentRelease},
+		{"example.com/recent", "v0.9.0", ReasonActive},
+		{"example.com/untagged", "", ReasonActive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.module, func(t *testing.T) {
+			committed := now.Add(-5 * 24 * time.Hour)
+			repoInfo := &types.RepoInfo{Exists: true, LastCommitAt: &committed}
+
+			a.attachReleaseInfo(context.Background(), tt.module, repoInfo, nil, "", "")
+			if repoInfo.LatestRelease != tt.wantRelease {
+				t.Errorf("LatestRelease = %q, want %q", repoInfo.LatestRelease, tt.wantRelease)
+			}
+
+			result, _ := a.applyRepoHeuristics(Result{}, repoInfo, "GitLab")
+			if result.Reason != tt.wantReason {
+				t.Errorf("Reason = %v, want %v (%s)", result.Reason, tt.wantReason, result.Details)
+			}
+		})
+	}
+}
+
+func TestCheckResponsiveness(t *testing.T) {
+	a := &Analyzer{config: Config{MaxResponseTime: 30 * 24 * time.Hour}}
+
+	tests := []struct {
+		name           string
+		responsiveness *types.Responsiveness
+		want           bool
+	}{
+		{"not sampled", nil, false},
+		{"too few items", &types.Responsiveness{Sampled: 2, MedianResponse: 90 * 24 * time.Hour}, false},
+		{"quick maintainers", &types.Responsiveness{Sampled: 20, Responded: 19, MedianResponse: 2 * 24 * time.Hour}, false},
+		{"ignored issues", &types.Responsiveness{Sampled: 20, Responded: 3, Open: 17, Closed: 3, MedianResponse: 120 * 24 * time.Hour}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Result{Responsiveness: tt.responsiveness}
+			if got := a.checkResponsiveness(&result); got != tt.want {
+				t.Fatalf("checkResponsiveness() = %v, want %v", got, tt.want)
+			}
+			if tt.want && (result.Reason != ReasonUnresponsive || !result.IsUnmaintained) {
+				t.Errorf("Reason = %v, IsUnmaintained = %v; want %v, true", result.Reason, result.IsUnmaintained, ReasonUnresponsive)
+			}
+		})
+	}
+}
+
+func TestCheckBusFactor(t *testing.T) {
+	now := time.Now()
+	recent := now.Add(-30 * 24 * time.Hour)
+	quiet := now.Add(-400 * 24 * time.Hour)
+	a := &Analyzer{config: Config{BusFactorWindow: 180 * 24 * time.Hour}}
+
+	tests := []struct {
+		name         string
+		contributors []types.Contributor
+		want         bool
+		wantActive   int
+	}{
+		{"no statistics", nil, false, 0},
+		{
+			name:         "sole maintainer still active",
+			contributors: []types.Contributor{{Login: "owner", Commits: 95, LastCommitAt: &recent}, {Login: "drive-by", Commits: 5, LastCommitAt: &quiet}},
+			wantActive:   1,
+		},
+		{
+			name:         "sole maintainer gone quiet",
+			contributors: []types.Contributor{{Login: "owner", Commits: 95, LastCommitAt: &quiet}, {Login: "drive-by", Commits: 5, LastCommitAt: &quiet}},
+			want:         true,
+		},
+		{
+			// A newer committer has taken over, so the repository is not abandoned
+			name:         "handed over to a new maintainer",
+			contributors: []types.Contributor{{Login: "owner", Commits: 95, LastCommitAt: &quiet}, {Login: "successor", Commits: 5, LastCommitAt: &recent}},
+			wantActive:   1,
+		},
+		{
+			name:         "shared maintenance",
+			contributors: []types.Contributor{{Login: "a", Commits: 60, LastCommitAt: &quiet}, {Login: "b", Commits: 40, LastCommitAt: &recent}},
+			wantActive:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Result{}
+			got := a.checkBusFactor(&result, &types.RepoInfo{Exists: true, Contributors: tt.contributors})
+			if got != tt.want {
+				t.Fatalf("checkBusFactor() = %v, want %v", got, tt.want)
+			}
+			if result.ActiveCommitters != tt.wantActive {
+				t.Errorf("ActiveCommitters = %d, want %d", result.ActiveCommitters, tt.wantActive)
+			}
+			if tt.want && result.Reason != ReasonSoleMaintainer {
+				t.Errorf("Reason = %v, want %v", result.Reason, ReasonSoleMaintainer)
+			}
+		})
+	}
+}
+
+func TestAnalyzeGitHub_MovedRepository(t *testing.T) {
+	goMod := func(modulePath string) string {
+		content := base64.StdEncoding.EncodeToString([]byte("module " + modulePath + "\n"))
+		return fmt.Sprintf(`{"type":"file","encoding":"base64","content":%q}`, content)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/meta":
+			fmt.Fprint(w, `{}`)
+		case "/api/v3/repos/old/renamed", "/api/v3/repos/old/transferred":
+			// GitHub follows the redirect and answers with the new location
+			name := strings.TrimPrefix(r.URL.Path, "/api/v3/repos/old/")
+			fmt.Fprintf(w, `{"full_name":"new/%s","pushed_at":%q}`, name, time.Now().UTC().Format(time.RFC3339))
+		case "/api/v3/repos/new/renamed/contents/go.mod":
+			fmt.Fprint(w, goMod("ghe.test/new/renamed"))
+		case "/api/v3/repos/new/transferred/contents/go.mod":
+			fmt.Fprint(w, goMod("ghe.test/old/transferred"))
+		case "/api/v3/repos/old/renamed/commits", "/api/v3/repos/old/transferred/commits":
+			fmt.Fprint(w, `[]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client, err := github.NewEnterpriseClient(github.EnterpriseHost{Host: "ghe.test", APIURL: server.URL + "/api/v3/", Token: "test"})
+	if err != nil {
+		t.Fatalf("NewEnterpriseClient() error: %v", err)
+	}
+	noCache, _ := cache.NewCache(true, 0)
+	a := &Analyzer{
+		enterpriseClients: map[string]*github.Client{"ghe.test": client},
+		cache:             noCache,
+		config:            Config{MaxAge: 365 * 24 * time.Hour},
+	}
+
+	tests := []struct {
+		path        string
+		wantReason  UnmaintainedReason
+		wantMovedTo string
+	}{
+		{"ghe.test/old/renamed", ReasonMoved, "ghe.test/new/renamed"},
+		// The new go.mod still declares the old path, so the module keeps working
+		{"ghe.test/old/transferred", ReasonActive, "ghe.test/new/transferred"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			dep := parser.Dependency{Path: tt.path, Version: "v1.0.0"}
+			result, analyzeErr := a.analyzeGitHub(context.Background(), dep, parser.ParseModulePath(tt.path))
+			if analyzeErr != nil {
+				t.Fatalf("analyzeGitHub() error: %v", analyzeErr)
+			}
+			if result.Reason != tt.wantReason || result.MovedTo != tt.wantMovedTo {
+				t.Errorf("Reason = %v, MovedTo = %q; want %v, %q (%s)", result.Reason, result.MovedTo, tt.wantReason, tt.wantMovedTo, result.Details)
+			}
+		})
+	}
+}
+
+func TestAnalyzeGitHub_NestedModule(t *testing.T) {
+	commitsJSON := func(daysAgo int) string {
+		date := time.Now().Add(-time.Duration(daysAgo) * 24 * time.Hour).UTC().Format(time.RFC3339)
+		return fmt.Sprintf(`[{"sha":"abc","author":{"login":"alice"},"commit":{"committer":{"date":%q}}}]`, date)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/meta":
+			fmt.Fprint(w, `{}`)
+		case "/api/v3/repos/org/mono":
+			fmt.Fprintf(w, `{"full_name":"org/mono","default_branch":"main","pushed_at":%q}`, time.Now().UTC().Format(time.RFC3339))
+		case "/api/v3/repos/org/mono/commits":
+			switch r.URL.Query().Get("path") {
+			case "legacy":
+				fmt.Fprint(w, commitsJSON(800))
+			case "fresh":
+				fmt.Fprint(w, commitsJSON(20))
+			default:
+				fmt.Fprint(w, commitsJSON(2))
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client, err := github.NewEnterpriseClient(github.EnterpriseHost{Host: "ghe.test", APIURL: server.URL + "/api/v3/", Token: "test"})
+	if err != nil {
+		t.Fatalf("NewEnterpriseClient() error: %v", err)
+	}
+	noCache, _ := cache.NewCache(true, 0)
+	a := &Analyzer{
+		enterpriseClients: map[string]*github.Client{"ghe.test": client},
+		cache:             noCache,
+		config:            Config{MaxAge: 365 * 24 * time.Hour},
+	}
+
+	tests := []struct {
+		path        string
+		wantReason  UnmaintainedReason
+		wantDir     string
+		wantDays    int
+		wantDetails string
+	}{
+		// The repository is busy, but nothing under legacy/ has changed in years
+		{"ghe.test/org/mono/legacy/v2", ReasonStaleInactive, "legacy", 800, "Module directory legacy/ inactive for 800 days"},
+		{"ghe.test/org/mono/fresh", ReasonActive, "fresh", 20, "Active module directory fresh/"},
+		{"ghe.test/org/mono", ReasonActive, "", 0, "Active repository"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			dep := parser.Dependency{Path: tt.path, Version: "v2.0.0"}
+			result, analyzeErr := a.analyzeGitHub(context.Background(), dep, a.parseModulePath(tt.path))
+			if analyzeErr != nil {
+				t.Fatalf("analyzeGitHub() error: %v", analyzeErr)
+			}
+			if result.Reason != tt.wantReason || result.DaysSinceUpdate != tt.wantDays {
+				t.Errorf("Reason = %v, DaysSinceUpdate = %d; want %v, %d", result.Reason, result.DaysSinceUpdate, tt.wantReason, tt.wantDays)
+			}
+			if !strings.Contains(result.Details, tt.wantDetails) {
+				t.Errorf("Details = %q, want it to contain %q", result.Details, tt.wantDetails)
+			}
+			if tt.wantDir == "" {
+				if result.Submodule != nil {
+					t.Errorf("Submodule = %+v, want nil for the root module", result.Submodule)
+				}
+				return
+			}
+			if result.Submodule == nil || result.Submodule.Dir != tt.wantDir {
+				t.Fatalf("Submodule = %+v, want directory %s", result.Submodule, tt.wantDir)
+			}
+			if result.RepoDaysSinceUpdate != 0 {
+				t.Errorf("RepoDaysSinceUpdate = %d, want 0", result.RepoDaysSinceUpdate)
+			}
+		})
+	}
+}
+
+func TestCompareWithParent(t *testing.T) {
+	recent := time.Now().Add(-10 * 24 * time.Hour)
+	old := time.Now().Add(-900 * 24 * time.Hour)
+	// Without a client for the host, parents are judged by their last push
+	noCache, _ := cache.NewCache(true, 0)
+	a := &Analyzer{cache: noCache, config: Config{MaxAge: 365 * 24 * time.Hour}}
+
+	tests := []struct {
+		name          string
+		unmaintained  bool
+		parent        *types.ForkParent
+		wantHealthier string
+		wantNote      string
+	}{
+		{"not a fork", true, nil, "", ""},
+		{"stale fork of active parent", true, &types.ForkParent{FullName: "up/lib", PushedAt: &recent}, "github.com/up/lib", "which is active"},
+		{"active fork of archived parent", false, &types.ForkParent{FullName: "up/lib", PushedAt: &recent, IsArchived: true}, "", "this fork is the healthier repository"},
+		{"active fork of inactive parent", false, &types.ForkParent{FullName: "up/lib", PushedAt: &old}, "", "this fork is the healthier repository"},
+		{"both active", false, &types.ForkParent{FullName: "up/lib", PushedAt: &recent}, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Result{
+				IsUnmaintained: tt.unmaintained,
+				Details:        "details",
+				RepoInfo:       &types.RepoInfo{Exists: true, IsFork: tt.parent != nil, Parent: tt.parent},
+			}
+			a.compareWithParent(context.Background(), &result, "github.com")
+
+			if result.HealthierRepository != tt.wantHealthier {
+				t.Errorf("HealthierRepository = %q, want %q", result.HealthierRepository, tt.wantHealthier)
+			}
+			if tt.wantNote == "" && result.Details != "details" {
+				t.Errorf("Details = %q, want unchanged", result.Details)
+			}
+			if tt.wantNote != "" && !strings.Contains(result.Details, tt.wantNote) {
+				t.Errorf("Details = %q, want it to mention %q", result.Details, tt.wantNote)
+			}
+		})
+	}
+}
+
+func TestCompareWithParent_ActivitySignals(t *testing.T) {
+	daysAgo := func(days int) string {
+		return time.Now().Add(-time.Duration(days) * 24 * time.Hour).UTC().Format(time.RFC3339)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/meta":
+			fmt.Fprint(w, `{}`)
+		case "/api/v3/repos/up/lib":
+			// Pushed to recently, but only by a bot
+			fmt.Fprintf(w, `{"full_name":"up/lib","default_branch":"main","pushed_at":%q}`, daysAgo(3))
+		case "/api/v3/repos/up/lib/commits":
+			fmt.Fprintf(w, `[{"sha":"a","author":{"login":"dependabot[bot]"},"commit":{"committer":{"date":%q}}},`+
+				`{"sha":"b","author":{"login":"alice"},"commit":{"committer":{"date":%q}}}]`, daysAgo(3), daysAgo(800))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client, err := github.NewEnterpriseClient(github.EnterpriseHost{Host: "ghe.test", APIURL: server.URL + "/api/v3/", Token: "test"})
+	if err != nil {
+		t.Fatalf("NewEnterpriseClient() error: %v", err)
+	}
+	noCache, _ := cache.NewCache(true, 0)
+
+	tests := []struct {
+		name          string
+		signals       []types.ActivitySignal
+		wantHealthier string
+	}{
+		{"default signals count the bot push", nil, "ghe.test/up/lib"},
+		{"human commits only", []types.ActivitySignal{types.ActivityLastHumanCommit}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client.SetHumanCommits(slices.Contains(tt.signals, types.ActivityLastHumanCommit))
+			a := &Analyzer{
+				enterpriseClients: map[string]*github.Client{"ghe.test": client},
+				cache:             noCache,
+				config:            Config{MaxAge: 365 * 24 * time.Hour, ActivitySignals: tt.signals},
+			}
+			result := Result{
+				IsUnmaintained: true,
+				RepoInfo:       &types.RepoInfo{Exists: true, IsFork: true, Parent: &types.ForkParent{FullName: "up/lib"}},
+			}
+			a.compareWithParent(context.Background(), &result, "ghe.test")
+
+			if result.HealthierRepository != tt.wantHealthier {
+				t.Errorf("HealthierRepository = %q, want %q (%s)", result.HealthierRepository, tt.wantHealthier, result.Details)
+			}
+		})
+	}
+}
+
+func TestApplyVersionLag(t *testing.T) {
+	a := &Analyzer{
+		resolver: newTestProxyResolver(t, map[string]string{
+			"/example.com/lib/@v/list":        "v1.0.0\nv1.1.0\nv1.2.0\n",
+			"/example.com/lib/@v/v1.0.0.info": `{"Version":"v1.0.0","Time":"2022-01-01T00:00:00Z"}`,
+			"/example.com/lib/@v/v1.2.0.info": `{"Version":"v1.2.0","Time":"2022-03-02T00:00:00Z"}`,
+		}),
+	}
+
+	tests := []struct {
+		name       string
+		result     Result
+		version    string
+		wantReason UnmaintainedReason
+		wantBehind int
+	}{
+		{"behind latest", Result{Reason: ReasonActive}, "v1.0.0", ReasonOutdated, 2},
+		{"up to date", Result{Reason: ReasonActive}, "v1.2.0", ReasonActive, 0},
+		{"archived stays archived", Result{Reason: ReasonArchived, IsUnmaintained: true}, "v1.0.0", ReasonArchived, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.result
+			a.applyVersionLag(context.Background(), &result, parser.Dependency{Path: "example.com/lib", Version: tt.version})
+			if result.Reason != tt.wantReason || result.ReleasesBehind != tt.wantBehind {
+				t.Errorf("Reason = %v, ReleasesBehind = %d; want %v, %d", result.Reason, result.ReleasesBehind, tt.wantReason, tt.wantBehind)
+			}
+			if result.LatestVersion != "v1.2.0" {
+				t.Errorf("LatestVersion = %q, want v1.2.0", result.LatestVersion)
+			}
+			if tt.version == "v1.0.0" && result.DaysBehind != 60 {
+				t.Errorf("DaysBehind = %d, want 60", result.DaysBehind)
+			}
+		})
+	}
+}
+
+func TestApplySuggestion(t *testing.T) {
+	a := &Analyzer{
+		resolver: newTestProxyResolver(t, map[string]string{
+			"/example.com/gone/@latest":       `{"Version":"v1.2.0","Time":"2021-01-01T00:00:00Z"}`,
+			"/example.com/gone/@v/v1.2.0.mod": "// Deprecated: use example.com/next instead.\nmodule example.com/gone\n",
+		}),
+	}
+
+	archived := &types.RepoInfo{Exists: true, IsArchived: true, Description: "ARCHIVED: moved to https://codeberg.org/owner/lib"}
+
+	tests := []struct {
+		name       string
+		module     string
+		result     Result
+		want       string
+		wantSource SuggestionSource
+	}{
+		{"curated mapping", "github.com/pkg/errors", Result{IsUnmaintained: true}, "errors (standard library)", SuggestionCurated},
+		{"deprecation notice", "example.com/gone", Result{IsUnmaintained: true}, "example.com/next", SuggestionDeprecation},
+		{"archived description", "github.com/owner/lib", Result{IsUnmaintained: true, RepoInfo: archived}, "codeberg.org/owner/lib", SuggestionDescription},
+		{"moved repository", "github.com/old/lib", Result{IsUnmaintained: true, MovedTo: "github.com/new/lib", MovedModule: "github.com/new/lib"}, "github.com/new/lib", SuggestionMoved},
+		{"major version", "example.com/lib", Result{IsUnmaintained: true, SupersededBy: "example.com/lib/v2"}, "example.com/lib/v2", SuggestionMajorVersion},
+		{"fork parent", "github.com/fork/lib", Result{IsUnmaintained: true, HealthierRepository: "github.com/up/lib"}, "github.com/up/lib", SuggestionForkParent},
+		{"maintained", "github.com/pkg/errors", Result{SupersededBy: "example.com/lib/v2"}, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.result
+			a.applySuggestion(context.Background(), &result, parser.Dependency{Path: tt.module})
+			if !strings.HasPrefix(result.Suggestion, tt.want) || (tt.want == "" && result.Suggestion != "") || result.SuggestionSource != tt.wantSource {
+				t.Errorf("Suggestion = %q from %q, want %q from %q", result.Suggestion, result.SuggestionSource, tt.want, tt.wantSource)
+			}
+		})
+	}
+}
+
+func TestApplyMajorVersionCheck(t *testing.T) {
+	now := time.Now().UTC()
+	a := &Analyzer{
+		resolver: newTestProxyResolver(t, map[string]string{
+			"/example.com/abandoned/@latest":    fmt.Sprintf(`{"Version":"v1.9.0","Time":%q}`, now.Add(-800*24*time.Hour).Format(time.RFC3339)),
+			"/example.com/abandoned/v2/@latest": fmt.Sprintf(`{"Version":"v2.3.0","Time":%q}`, now.Add(-10*24*time.Hour).Format(time.RFC3339)),
+			"/example.com/patched/@latest":      fmt.Sprintf(`{"Version":"v1.9.1","Time":%q}`, now.Add(-20*24*time.Hour).Format(time.RFC3339)),
+			"/example.com/patched/v2/@latest":   fmt.Sprintf(`{"Version":"v2.3.0","Time":%q}`, now.Add(-10*24*time.Hour).Format(time.RFC3339)),
+		}),
+		config: Config{MaxAge: 365 * 24 * time.Hour},
+	}
+
+	tests := []struct {
+		name           string
+		result         Result
+		module         string
+		wantReason     UnmaintainedReason
+		wantSuperseded string
+	}{
+		{"abandoned major version", Result{Reason: ReasonActive}, "example.com/abandoned", ReasonSuperseded, "example.com/abandoned/v2"},
+		{"major version still patched", Result{Reason: ReasonActive}, "example.com/patched", ReasonActive, "example.com/patched/v2"},
+		{"archived stays archived", Result{Reason: ReasonArchived, IsUnmaintained: true}, "example.com/abandoned", ReasonArchived, "example.com/abandoned/v2"},
+		{"no newer major version", Result{Reason: ReasonActive}, "example.com/abandoned/v2", ReasonActive, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.result
+			a.applyMajorVersionCheck(context.Background(), &result, parser.Dependency{Path: tt.module, Version: "v1.0.0"})
+			if result.Reason != tt.wantReason || result.SupersededBy != tt.wantSuperseded {
+				t.Errorf("Reason = %v, SupersededBy = %q; want %v, %q", result.Reason, result.SupersededBy, tt.wantReason, tt.wantSuperseded)
+			}
+			if result.Reason == ReasonSuperseded && !strings.Contains(result.Details, "major version v2") {
+				t.Errorf("Details = %q, want it to name major version v2", result.Details)
+			}
+		})
+	}
+}
+
+func TestCheckMaintenanceNotice(t *testing.T) {
+	tests := []struct {
+		name       string
+		repoInfo   types.RepoInfo
+		patterns   []string
+		wantSource NoticeSource
+		wantNotice string
+	}{
+		{
+			name:       "description",
+			repoInfo:   types.RepoInfo{Description: "This project is no longer maintained, use example.com/new instead"},
+			wantSource: NoticeDescription,
+			wantNotice: "This project is no longer maintained, use example.com/new instead",
+		},
+		{
+			name:       "topic",
+			repoInfo:   types.RepoInfo{Description: "A YAML library", Topics: []string{"yaml", "no-longer-maintained"}},
+			wantSource: NoticeTopics,
+			wantNotice: "no-longer-maintained",
+		},
+		{
+			name:       "readme banner",
+			repoInfo:   types.RepoInfo{ReadmeHeader: "# lib\n\n> **DEPRECATED**: see the v2 module\n\nlib does things."},
+			wantSource: NoticeReadme,
+			wantNotice: "**DEPRECATED**: see the v2 module",
+		},
+		{
+			name:       "readme heading",
+			repoInfo:   types.RepoInfo{ReadmeHeader: "# lib\n\n## Deprecated\n\nUse example.com/new."},
+			wantSource: NoticeReadme,
+			wantNotice: "Deprecated",
+		},
+		{
+			name:       "description banner",
+			repoInfo:   types.RepoInfo{Description: "DEPRECATED - use example.com/new"},
+			wantSource: NoticeDescription,
+			wantNotice: "DEPRECATED - use example.com/new",
+		},
+		{
+			name:       "project no longer developed",
+			repoInfo:   types.RepoInfo{Description: "This package is no longer actively developed"},
+			wantSource: NoticeDescription,
+			wantNotice: "This package is no longer actively developed",
+		},
+		{
+			name:     "unsupported Go version",
+			repoInfo: types.RepoInfo{ReadmeHeader: "# lib\n\nGo 1.17 is no longer supported; use Go 1.21 or later."},
+		},
+		{
+			name:     "changelog list item",
+			repoInfo: types.RepoInfo{ReadmeHeader: "# lib\n\n## Changes in v2\n\n- Deprecated `Foo` removed in v2\n* Obsolete options dropped"},
+		},
+		{
+			name:     "paragraph starting with the word",
+			repoInfo: types.RepoInfo{ReadmeHeader: "# lib\n\nDeprecated APIs are listed in DEPRECATIONS.md."},
+		},
+		{
+			name:     "deprecated feature mentioned in passing",
+			repoInfo: types.RepoInfo{ReadmeHeader: "# lib\n\nReplaces the deprecated io/ioutil helpers."},
+		},
+		{
+			name:     "tool about unmaintained dependencies",
+			repoInfo: types.RepoInfo{Description: "Find unmaintained Go dependencies", Topics: []string{"go-unmaintained"}},
+		},
+		{
+			name:     "patterns disabled",
+			repoInfo: types.RepoInfo{Description: "No longer maintained"},
+			patterns: []string{""},
+		},
+		{
+			name:       "custom pattern",
+			repoInfo:   types.RepoInfo{Description: "Feature complete; see the fork"},
+			patterns:   []string{`see the fork`},
+			wantSource: NoticeDescription,
+			wantNotice: "Feature complete; see the fork",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &Analyzer{}
+			if tt.patterns != nil {
+				patterns, err := compileNoticePatterns(tt.patterns)
+				if err != nil {
+					t.Fatalf("compileNoticePatterns() error: %v", err)
+				}
+				a.noticePatterns = patterns
+			}
+
+			var result Result
+			found := a.checkMaintenanceNotice(&result, &tt.repoInfo)
+			if found != (tt.wantSource != "") {
+				t.Fatalf("checkMaintenanceNotice() = %v (%s)", found, result.Details)
+			}
+			if result.NoticeSource != tt.wantSource || result.MaintenanceNotice != tt.wantNotice {
+				t.Errorf("notice = %s %q, want %s %q", result.NoticeSource, result.MaintenanceNotice, tt.wantSource, tt.wantNotice)
+			}
+			if found && result.Reason != ReasonSelfDeclaredUnmaintained {
+				t.Errorf("Reason = %v, want %v", result.Reason, ReasonSelfDeclaredUnmaintained)
+			}
+		})
+	}
+
+	if _, err := compileNoticePatterns([]string{"("}); err == nil {
+		t.Error("compileNoticePatterns() accepted an invalid pattern")
+	}
+}
+
+func TestApplyVulnerabilities(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"index/modules.json": `[{"path":"example.com/dead","vulns":[{"id":"GO-2024-0001"}]}]`,
+		"ID/GO-2024-0001.json": `{"id":"GO-2024-0001","summary":"Unbounded allocation",
+			"affected":[{"package":{"name":"example.com/dead","ecosystem":"Go"},"ranges":[{"type":"SEMVER","events":[{"introduced":"0"}]}]}]}`,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db, err := vulndb.Open(dir)
+	if err != nil {
+		t.Fatalf("vulndb.Open() error: %v", err)
+	}
+	a := &Analyzer{vulnDB: db}
+
+	dead := Result{Package: "example.com/dead", IsUnmaintained: true, Reason: ReasonArchived}
+	a.applyVulnerabilities(&dead, parser.Dependency{Path: "example.com/dead", Version: "v1.0.0"})
+	if len(dead.Vulnerabilities) != 1 || !dead.HasUnfixedVulnerability() {
+		t.Errorf("Vulnerabilities = %+v, want GO-2024-0001 with no fix", dead.Vulnerabilities)
+	}
+
+	alive := Result{Package: "example.com/alive", Reason: ReasonActive}
+	a.applyVulnerabilities(&alive, parser.Dependency{Path: "example.com/alive", Version: "v1.0.0"})
+	if len(alive.Vulnerabilities) != 0 {
+		t.Errorf("Vulnerabilities = %+v, want none", alive.Vulnerabilities)
+	}
+
+	summary := GetSummary([]Result{dead, alive})
+	if summary.VulnerableCount != 1 || summary.UnmaintainedVulnerableCount != 1 {
+		t.Errorf("VulnerableCount = %d, UnmaintainedVulnerableCount = %d; want 1, 1", summary.VulnerableCount, summary.UnmaintainedVulnerableCount)
+	}
+}
+
+func TestApplyLicenseCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		latest string
+		pinned string
+		want   LicenseAlert
+	}{
+		{"unchanged", "MIT", "MIT", ""},
+		{"relicensed", "BUSL-1.1", "Apache-2.0", LicenseChanged},
+		{"no license", types.NoLicense, "", LicenseMissing},
+		{"pinned license unknown", "MIT", "", ""},
+		{"host reports no licenses", "", "MIT", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Result{CurrentVersion: "v1.0.0", PinnedLicense: tt.pinned, RepoInfo: &types.RepoInfo{License: tt.latest}}
+			applyLicenseCheck(&result)
+			if result.LicenseAlert != tt.want {
+				t.Errorf("LicenseAlert = %q, want %q (%s)", result.LicenseAlert, tt.want, result.Details)
+			}
+		})
+	}
+}
+
+func TestVersionRef(t *testing.T) {
+	tests := []struct {
+		version string
+		subdir  string
+		want    string
+	}{
+		{"v1.2.3", "", "v1.2.3"},
+		{"v2.0.0+incompatible", "", "v2.0.0"},
+		{"v1.2.3", "sub", "sub/v1.2.3"},
+		{"v0.0.0-20200101000000-abcdefabcdef", "sub", "abcdefabcdef"},
+	}
+
+	for _, tt := range tests {
+		if got := versionRef(tt.version, tt.subdir); got != tt.want {
+			t.Errorf("versionRef(%q, %q) = %q, want %q", tt.version, tt.subdir, got, tt.want)
+		}
+	}
+}
+
+func TestApplyScorecard(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scorecard.json")
+	results := `{"date":"2024-06-01","repo":{"name":"github.com/org/quiet"},"score":6.1,"checks":[{"name":"Maintained","score":0}]}
+{"date":"2024-06-01","repo":{"name":"github.com/org/busy"},"score":7.9,"checks":[{"name":"Maintained","score":10}]}
+{"date":"2024-06-01","repo":{"name":"gitlab.com/org/lowscore"},"score":2.5,"checks":[{"name":"Maintained","score":-1}]}`
+	if err := os.WriteFile(path, []byte(results), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	scorecards, err := scorecard.Load(path)
+	if err != nil {
+		t.Fatalf("scorecard.Load() error: %v", err)
+	}
+	a := &Analyzer{scorecards: scorecards, config: Config{MinMaintainedScore: 5, MinScorecardScore: 4}}
+
+	tests := []struct {
+		module     string
+		reason     UnmaintainedReason
+		wantReason UnmaintainedReason
+		wantScore  bool
+	}{
+		{"github.com/org/quiet", ReasonActive, ReasonLowScorecard, true},
+		{"github.com/org/busy/v2", ReasonActive, ReasonActive, true},
+		{"gitlab.com/org/lowscore", ReasonActive, ReasonLowScorecard, true},
+		{"github.com/org/quiet", ReasonArchived, ReasonArchived, true},
+		{"github.com/org/unscored", ReasonActive, ReasonActive, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.module+"/"+string(tt.reason), func(t *testing.T) {
+			result := Result{Package: tt.module, Reason: tt.reason}
+			a.applyScorecard(&result, parser.Dependency{Path: tt.module})
+			if (result.Scorecard != nil) != tt.wantScore {
+				t.Errorf("Scorecard = %+v, want attached: %v", result.Scorecard, tt.wantScore)
+			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("Reason = %v, want %v (%s)", result.Reason, tt.wantReason, result.Details)
+			}
+		})
+	}
+}
+
+func TestApplyModHygiene(t *testing.T) {
+	now := time.Now().UTC().Format(time.RFC3339)
+	a := &Analyzer{
+		resolver: newTestProxyResolver(t, map[string]string{
+			"/example.com/tidy/@latest":                         fmt.Sprintf(`{"Version":"v1.5.0","Time":%q}`, now),
+			"/example.com/tidy/@v/v1.5.0.mod":                   "module example.com/tidy\n\ngo 1.22\n\nrequire example.com/dep v1.0.0\n",
+			"/example.com/rotten/@latest":                       fmt.Sprintf(`{"Version":"v1.1.0","Time":%q}`, now),
+			"/example.com/rotten/@v/v1.1.0.mod":                 "module example.com/rotten\n\ngo 1.13\n\nrequire (\n\tgithub.com/pkg/errors v0.9.1\n\texample.com/dead v1.0.0\n\texample.com/dep v1.0.0\n)\n",
+			"/github.com/org/legacy/@latest":                    fmt.Sprintf(`{"Version":"v2.3.0+incompatible","Time":%q}`, now),
+			"/github.com/org/legacy/@v/v2.3.0+incompatible.mod": "module github.com/org/legacy\n",
+		}),
+		config: Config{CheckGoMod: true},
+	}
+
+	tests := []struct {
+		module           string
+		wantGo           string
+		wantNoModules    bool
+		wantUnmaintained int
+		wantRot          int
+	}{
+		{"example.com/tidy", "1.22", false, 0, 0},
+		// example.com/dead is flagged by the analysis; github.com/pkg/errors only has a
+		// curated successor, which does not make it unmaintained
+		{"example.com/rotten", "1.13", false, 1, rotPerUnmaintainedRequire + rotOldGoVersion},
+		{"github.com/org/legacy", "", true, 0, rotNoModuleSupport + rotOldGoVersion},
+	}
+
+	results := []Result{{Package: "example.com/dead", IsUnmaintained: true}}
+	for _, tt := range tests {
+		result := Result{Package: tt.module}
+		a.applyModHygiene(context.Background(), &result, parser.Dependency{Path: tt.module, Version: "v1.0.0"})
+		if result.ModHygiene == nil {
+			t.Fatalf("%s: ModHygiene not set", tt.module)
+		}
+		results = append(results, result)
+	}
+	rescoreModHygiene(results)
+
+	for i, tt := range tests {
+		t.Run(tt.module, func(t *testing.T) {
+			h := results[i+1].ModHygiene
+			if h.GoVersion != tt.wantGo || h.NoModuleSupport != tt.wantNoModules {
+				t.Errorf("GoVersion = %q, NoModuleSupport = %v; want %q, %v", h.GoVersion, h.NoModuleSupport, tt.wantGo, tt.wantNoModules)
+			}
+			if len(h.UnmaintainedRequirements) != tt.wantUnmaintained || h.RotScore != tt.wantRot {
+				t.Errorf("UnmaintainedRequirements = %v, RotScore = %d; want %d, %d", h.UnmaintainedRequirements, h.RotScore, tt.wantUnmaintained, tt.wantRot)
+			}
+		})
+	}
+}
+
+func TestRollupSubtrees(t *testing.T) {
+	graph, err := parser.ParseModuleGraph(strings.NewReader(`example.com/main example.com/active@v1.0.0
+example.com/main example.com/clean@v1.0.0
+example.com/main example.com/dead@v1.0.0
+example.com/main example.com/wrapped@v1.0.0
+example.com/active@v1.0.0 example.com/dead@v1.0.0
+example.com/active@v1.0.0 example.com/helper@v1.0.0
+example.com/helper@v1.0.0 github.com/pkg/errors@v0.9.1
+example.com/clean@v1.0.0 example.com/helper@v1.1.0
+example.com/helper@v1.1.0 example.com/util@v1.0.0
+example.com/wrapped@v1.0.0 github.com/hashicorp/go-multierror@v1.1.1
+github.com/hashicorp/go-multierror@v1.1.1 example.com/dead@v1.0.0
+`))
+	if err != nil {
+		t.Fatalf("ParseModuleGraph() error: %v", err)
+	}
+
+	results := []Result{
+		{Package: "example.com/active", IsDirect: true},
+		{Package: "example.com/clean", IsDirect: true},
+		{Package: "example.com/dead", IsDirect: true, IsUnmaintained: true},
+		{Package: "example.com/wrapped", IsDirect: true},
+		{Package: "example.com/helper"},
+	}
+	rollupSubtrees(graph, results)
+
+	tests := []struct {
+		module           string
+		wantModules      int
+		wantUnmaintained []string
+	}{
+		// helper is selected at v1.1.0, which no longer requires github.com/pkg/errors
+		{"example.com/active", 3, []string{"example.com/dead"}},
+		{"example.com/clean", 2, nil},
+		{"example.com/dead", 0, nil},
+		// go-multierror has a curated successor but is not flagged, so only dead counts
+		{"example.com/wrapped", 2, []string{"example.com/dead"}},
+	}
+	for i, tt := range tests {
+		t.Run(tt.module, func(t *testing.T) {
+			rot := results[i].Subtree
+			if rot == nil {
+				t.Fatal("Subtree not set")
+			}
+			if rot.Modules != tt.wantModules || !slices.Equal(rot.Unmaintained, tt.wantUnmaintained) {
+				t.Errorf("Subtree = %d modules, unmaintained %v; want %d, %v", rot.Modules, rot.Unmaintained, tt.wantModules, tt.wantUnmaintained)
+			}
+		})
+	}
+	if results[4].Subtree != nil {
+		t.Error("Subtree set for an indirect dependency")
+	}
+}
+
+func TestApplyHealthScore(t *testing.T) {
+	recent := time.Now().Add(-30 * 24 * time.Hour)
+	old := time.Now().Add(-400 * 24 * time.Hour)
+	a := &Analyzer{config: Config{BusFactorWindow: 180 * 24 * time.Hour}}
+
+	tests := []struct {
+		name         string
+		result       Result
+		contributors []types.Contributor
+		want         int
+	}{
+		{"shared and active", Result{}, []types.Contributor{{Login: "a", Commits: 50, LastCommitAt: &recent}, {Login: "b", Commits: 50, LastCommitAt: &recent}}, 100},
+		{"one active committer", Result{}, []types.Contributor{{Login: "a", Commits: 60, LastCommitAt: &recent}, {Login: "b", Commits: 40, LastCommitAt: &old}}, 100 - healthSingleActiveCommitter},
+		{"sole maintainer gone quiet", Result{IsUnmaintained: true}, []types.Contributor{{Login: "a", Commits: 95, LastCommitAt: &old}, {Login: "b", Commits: 5, LastCommitAt: &old}},
+			100 - healthUnmaintained - healthNoActiveCommitters - healthConcentrated},
+		{"no contributor data", Result{IsUnmaintained: true}, nil, 100 - healthUnmaintained},
+		{"unfixed vulnerability", Result{Vulnerabilities: []vulndb.Vulnerability{{ID: "GO-2024-0001"}}}, nil, 100 - healthUnfixedVulnerability},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.result
+			result.RepoInfo = &types.RepoInfo{Exists: true, Contributors: tt.contributors}
+			a.applyHealthScore(&result)
+			if result.HealthScore == nil || *result.HealthScore != tt.want {
+				t.Errorf("HealthScore = %v, want %d", result.HealthScore, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package analyzer
+
+import (
+	"context"
+	"go/version"
+	"strings"
+
+	"github.com/johnsaigle/go-unmaintained/pkg/parser"
+	"github.com/johnsaigle/go-unmaintained/pkg/popular"
+	"golang.org/x/mod/modfile"
+)
+
+// modernGoVersion is the first Go version whose go.mod files list every module needed to
+// build (module graph pruning); a go directive older than this has not been touched in years
+const modernGoVersion = "1.17"
+
+// Rot score weights, see ModHygiene.RotScore
+const (
+	rotNoModuleSupport        = 40
+	rotOldGoVersion           = 20
+	rotPerUnmaintainedRequire = 10
+	rotMaxUnmaintainedRequire = 40
+)
+
+// ModHygiene describes the go.mod of a dependency's latest version. A go.mod left behind by
+// the Go toolchain, or requiring unmaintained modules, is a sign of rot even while the
+// repository sees commits.
+type ModHygiene struct {
+	LatestVersion string
+	// GoVersion is the go directive; empty if there is none
+	GoVersion string
+	// Requirements are the module paths the go.mod requires
+	Requirements []string
+	// UnmaintainedRequirements are the Requirements this tool knows to be unmaintained
+	UnmaintainedRequirements []string
+	// NoModuleSupport is set when the latest version is +incompatible, or its go.mod is only
+	// a module line, as the module proxy synthesizes for repositories without one
+	NoModuleSupport bool
+	// RotScore adds up the signals, from 0 (healthy) to 100: 40 without module support, 20
+	// for a go directive older than 1.17 or none, and 10 per unmaintained requirement up to 40
+	RotScore int
+}
+
+// OldGoVersion reports whether the go directive is missing or predates modernGoVersion
+func (h *ModHygiene) OldGoVersion() bool {
+	return h.GoVersion == "" || version.Compare("go"+h.GoVersion, "go"+modernGoVersion) < 0
+}
+
+// score counts the requirements isUnmaintained reports and recomputes RotScore
+func (h *ModHygiene) score(isUnmaintained func(modulePath string) bool) {
+	h.UnmaintainedRequirements = nil
+	for _, path := range h.Requirements {
+		if isUnmaintained(path) {
+			h.UnmaintainedRequirements = append(h.UnmaintainedRequirements, path)
+		}
+	}
+
+	h.RotScore = min(len(h.UnmaintainedRequirements)*rotPerUnmaintainedRequire, rotMaxUnmaintainedRequire)
+	if h.NoModuleSupport {
+		h.RotScore += rotNoModuleSupport
+	}
+	if h.OldGoVersion() {
+		h.RotScore += rotOldGoVersion
+	}
+}
+
+// applyModHygiene reads the go.mod of the dependency's latest version from the module proxy.
+// Requirements are judged against the modules known to be unmaintained without analysis;
+// AnalyzeModule rescores them with the findings for the whole module graph.
+func (a *Analyzer) applyModHygiene(ctx context.Context, result *Result, dep parser.Dependency) {
+	if !a.config.CheckGoMod || a.resolver == nil {
+		return
+	}
+
+	latest, err := a.resolver.GetLatestInfo(ctx, dep.Path)
+	if err != nil {
+		return
+	}
+	f, err := a.resolver.GetModFile(ctx, dep.Path, latest.Version)
+	if err != nil {
+		return
+	}
+
+	hygiene := &ModHygiene{
+		LatestVersion:   latest.Version,
+		NoModuleSupport: strings.HasSuffix(latest.Version, "+incompatible") || isSynthesizedModFile(f),
+	}
+	if f.Go != nil {
+		hygiene.GoVersion = f.Go.Version
+	}
+	for _, req := range f.Require {
+		hygiene.Requirements = append(hygiene.Requirements, req.Mod.Path)
+	}
+	hygiene.score(func(modulePath string) bool {
+		return knownUnmaintained(modulePath, nil)
+	})
+	result.ModHygiene = hygiene
+}
+
+// isSynthesizedModFile reports whether a go.mod holds nothing but the module line
+func isSynthesizedModFile(f *modfile.File) bool {
+	return f.Go == nil && len(f.Require) == 0 && len(f.Replace) == 0 && len(f.Exclude) == 0 && len(f.Retract) == 0
+}
+
+// knownUnmaintained reports whether modulePath is flagged in this analysis, or known to be
+// unmaintained from the popular package cache. The curated replacements are not consulted:
+// they list modules with a suggested successor, many of which are still maintained.
+func knownUnmaintained(modulePath string, flagged map[string]bool) bool {
+	if flagged[modulePath] {
+		return true
+	}
+	entry, ok := popular.Lookup(modulePath)
+	return ok && entry.IsUnmaintained()
+}
+
+// rescoreModHygiene recounts the unmaintained requirements of every dependency's latest
+// go.mod with the dependencies flagged across the whole analysis
+func rescoreModHygiene(results []Result) {
+	flagged := make(map[string]bool)
+	for _, result := range results {
+		if result.IsUnmaintained {
+			flagged[result.Package] = true
+		}
+	}
+
+	for i := range results {
+		if hygiene := results[i].ModHygiene; hygiene != nil {
+			hygiene.score(func(modulePath string) bool {
+				return knownUnmaintained(modulePath, flagged)
+			})
+		}
+	}
+}
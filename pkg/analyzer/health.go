@@ -0,0 +1,43 @@
+package analyzer
+
+import "time"
+
+// Health score deductions, see Result.HealthScore
+const (
+	healthUnmaintained          = 50
+	healthNoActiveCommitters    = 30
+	healthSingleActiveCommitter = 15
+	healthConcentrated          = 10
+	healthUnfixedVulnerability  = 10
+)
+
+// applyHealthScore rates the dependency's maintenance from 0 to 100. An unmaintained
+// finding weighs most; the bus factor counts next, so an active repository carried by a
+// single committer scores below one with several. Without contributor statistics the bus
+// factor is not scored.
+func (a *Analyzer) applyHealthScore(result *Result) {
+	if result.RepoInfo == nil {
+		return
+	}
+
+	score := 100
+	if result.IsUnmaintained {
+		score -= healthUnmaintained
+	}
+	if _, share, ok := result.RepoInfo.TopContributor(); ok && a.config.BusFactorWindow > 0 {
+		switch result.RepoInfo.ActiveCommitters(time.Now().Add(-a.config.BusFactorWindow)) {
+		case 0:
+			score -= healthNoActiveCommitters
+		case 1:
+			score -= healthSingleActiveCommitter
+		}
+		if share >= soleMaintainerShare {
+			score -= healthConcentrated
+		}
+	}
+	if result.HasUnfixedVulnerability() {
+		score -= healthUnfixedVulnerability
+	}
+	score = max(score, 0)
+	result.HealthScore = &score
+}
@@ -0,0 +1,72 @@
+package analyzer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/johnsaigle/go-unmaintained/pkg/github"
+	"github.com/johnsaigle/go-unmaintained/pkg/parser"
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
+	"golang.org/x/mod/module"
+)
+
+// LicenseAlert names a license problem with a dependency. Forking an abandoned module
+// depends on its license, so alerts are reported whether or not it is maintained.
+type LicenseAlert string
+
+const (
+	// LicenseChanged is a repository whose license differs between the version in use and
+	// the default branch
+	LicenseChanged LicenseAlert = "license_changed"
+	// LicenseMissing is a repository with no license file
+	LicenseMissing LicenseAlert = "no_license"
+)
+
+// attachPinnedLicense records the license of a GitHub repository at the version in use,
+// for comparison with the default branch's. It costs a request per dependency, so it runs
+// only with CheckLicenses and a token.
+func (a *Analyzer) attachPinnedLicense(ctx context.Context, result *Result, dep parser.Dependency, subdir string, repoInfo *types.RepoInfo, client *github.Client, owner, repo string) {
+	if !a.config.CheckLicenses || client == nil || client.IsAnonymous() || !repoInfo.Exists || repoInfo.License == "" || dep.Version == "" {
+		return
+	}
+
+	license, err := client.GetLicense(ctx, owner, repo, versionRef(dep.Version, subdir))
+	if err != nil {
+		return
+	}
+	result.PinnedLicense = license
+}
+
+// versionRef returns the git ref a module version was built from: the commit of a
+// pseudo-version, or the tag of a release, prefixed with the module's directory
+func versionRef(version, subdir string) string {
+	if module.IsPseudoVersion(version) {
+		if rev, err := module.PseudoVersionRev(version); err == nil {
+			return rev
+		}
+	}
+	tag := strings.TrimSuffix(version, "+incompatible")
+	if subdir != "" {
+		tag = subdir + "/" + tag
+	}
+	return tag
+}
+
+// applyLicenseCheck raises a LicenseAlert for a repository without a license, or whose
+// license changed after the version in use
+func applyLicenseCheck(result *Result) {
+	if result.RepoInfo == nil || result.RepoInfo.License == "" {
+		return
+	}
+
+	latest := result.RepoInfo.License
+	switch {
+	case latest == types.NoLicense:
+		result.LicenseAlert = LicenseMissing
+		result.Details += "; the repository has no license"
+	case result.PinnedLicense != "" && result.PinnedLicense != latest:
+		result.LicenseAlert = LicenseChanged
+		result.Details += fmt.Sprintf("; license changed from %s at %s to %s", result.PinnedLicense, result.CurrentVersion, latest)
+	}
+}
@@ -0,0 +1,143 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
+)
+
+// DefaultNoticePatterns match the maintenance notices projects put in their repository
+// description, topics or README banner instead of archiving the repository. Patterns are
+// regular expressions matched case-insensitively; topics are matched with dashes as spaces.
+var DefaultNoticePatterns = []string{
+	`no longer (?:actively |being )?maintained`,
+	`not (?:actively |being )?maintained (?:anymore|any more)`,
+	`(?:project|repository|repo|package|library|module|code) is (?:not (?:actively |being )?|un)maintained`,
+	`(?:project|repository|repo|package|library|module) (?:is|has been) (?:deprecated|abandoned|discontinued)`,
+	// Only for the project itself: "Go 1.17 is no longer supported" is about something else
+	`(?:project|repository|repo|package|library|module) is no longer (?:actively )?(?:supported|developed|under (?:active )?development)`,
+	`looking for (?:a )?new maintainers?`,
+	// Banners: a description or topic that starts with the word, or a README heading or
+	// blockquote that does. List items such as "- Deprecated Foo removed" are changelogs.
+	`\A[ \t*_\[!:]*(?:deprecated|unmaintained|abandoned|obsolete)\b|(?m:^[ \t]*[#>][ \t#>*_\[!:-]*(?:deprecated|unmaintained|abandoned|obsolete)\b)`,
+}
+
+// maxNoticeLength bounds the text recorded as a MaintenanceNotice
+const maxNoticeLength = 120
+
+// NoticeSource identifies where a maintenance notice was found
+type NoticeSource string
+
+const (
+	NoticeDescription NoticeSource = "description"
+	NoticeTopics      NoticeSource = "topics"
+	NoticeReadme      NoticeSource = "readme"
+)
+
+// defaultNoticeRegexps are DefaultNoticePatterns compiled, used when no patterns are configured
+var defaultNoticeRegexps = mustCompileNoticePatterns(DefaultNoticePatterns)
+
+// compileNoticePatterns compiles notice patterns for case-insensitive matching
+func compileNoticePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		if pattern == "" {
+			continue
+		}
+		re, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid notice pattern %q: %w", pattern, err)
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+// mustCompileNoticePatterns compiles built-in patterns, which are known to be valid
+func mustCompileNoticePatterns(patterns []string) []*regexp.Regexp {
+	compiled, err := compileNoticePatterns(patterns)
+	if err != nil {
+		panic(err)
+	}
+	return compiled
+}
+
+// findMaintenanceNotice looks for a maintenance notice in the repository description,
+// topics and README header, in that order, and returns where it was found and the text
+// around the match
+func findMaintenanceNotice(repoInfo *types.RepoInfo, patterns []*regexp.Regexp) (NoticeSource, string, bool) {
+	if notice, ok := matchNotice(repoInfo.Description, patterns); ok {
+		return NoticeDescription, notice, true
+	}
+	for _, topic := range repoInfo.Topics {
+		if _, ok := matchNotice(strings.ReplaceAll(topic, "-", " "), patterns); ok {
+			return NoticeTopics, topic, true
+		}
+	}
+	if notice, ok := matchNotice(repoInfo.ReadmeHeader, patterns); ok {
+		return NoticeReadme, notice, true
+	}
+	return "", "", false
+}
+
+// matchNotice returns the line of text holding the first pattern match, without heading and
+// quote markers and cut to maxNoticeLength
+func matchNotice(text string, patterns []*regexp.Regexp) (string, bool) {
+	if text == "" {
+		return "", false
+	}
+	for _, re := range patterns {
+		loc := re.FindStringIndex(text)
+		if loc == nil {
+			continue
+		}
+
+		start := strings.LastIndexByte(text[:loc[0]], '\n') + 1
+		end := len(text)
+		if i := strings.IndexByte(text[loc[1]:], '\n'); i >= 0 {
+			end = loc[1] + i
+		}
+		line := text[start:end]
+		if len(line) > maxNoticeLength {
+			// Keep the match in view on long lines
+			line = text[loc[0]:end]
+		}
+		return truncateNotice(strings.TrimSpace(strings.TrimLeft(line, " \t#>"))), true
+	}
+	return "", false
+}
+
+// truncateNotice cuts a notice to maxNoticeLength runes
+func truncateNotice(notice string) string {
+	runes := []rune(notice)
+	if len(runes) <= maxNoticeLength {
+		return notice
+	}
+	return strings.TrimSpace(string(runes[:maxNoticeLength])) + "..."
+}
+
+// checkMaintenanceNotice flags a repository whose maintainers declare it unmaintained
+func (a *Analyzer) checkMaintenanceNotice(result *Result, repoInfo *types.RepoInfo) bool {
+	patterns := a.noticePatterns
+	if patterns == nil {
+		patterns = defaultNoticeRegexps
+	}
+
+	source, notice, ok := findMaintenanceNotice(repoInfo, patterns)
+	if !ok {
+		return false
+	}
+
+	result.IsUnmaintained = true
+	result.Reason = ReasonSelfDeclaredUnmaintained
+	result.MaintenanceNotice = notice
+	result.NoticeSource = source
+	where := "repository " + string(source)
+	if source == NoticeReadme {
+		where = "README"
+	}
+	result.Details = fmt.Sprintf("Maintainers declare it unmaintained in the %s: %q", where, notice)
+	return true
+}
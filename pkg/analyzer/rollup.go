@@ -0,0 +1,52 @@
+package analyzer
+
+import (
+	"context"
+
+	"github.com/johnsaigle/go-unmaintained/pkg/parser"
+)
+
+// SubtreeRot counts the unmaintained modules a direct dependency brings into the build.
+// An active dependency that pins archived modules is still a liability.
+type SubtreeRot struct {
+	// Unmaintained are the unmaintained modules in the subtree, sorted
+	Unmaintained []string
+	// Modules counts every module in the subtree
+	Modules int
+}
+
+// applyRollup propagates findings through the module graph: each direct dependency gets
+// the unmaintained modules it pulls in, directly or through other modules. Modules in the
+// graph but not in go.mod, as with go directives before 1.17, are judged by the popular
+// package data. Without a module graph the rollup is left out, as dependency paths are.
+func (a *Analyzer) applyRollup(ctx context.Context, mod *parser.Module, results []Result) {
+	graph, err := parser.GetModuleGraph(ctx, mod.ProjectPath)
+	if err != nil {
+		return
+	}
+	rollupSubtrees(graph, results)
+}
+
+// rollupSubtrees sets the Subtree of every direct dependency in results from graph
+func rollupSubtrees(graph *parser.ModuleGraph, results []Result) {
+	flagged := make(map[string]bool)
+	for _, result := range results {
+		if result.IsUnmaintained {
+			flagged[result.Package] = true
+		}
+	}
+
+	for i := range results {
+		if !results[i].IsDirect {
+			continue
+		}
+		subtree := graph.Subtree(results[i].Package)
+		rot := &SubtreeRot{Modules: len(subtree)}
+		for _, modulePath := range subtree {
+			if knownUnmaintained(modulePath, flagged) {
+				rot.Unmaintained = append(rot.Unmaintained, modulePath)
+			}
+		}
+		results[i].Subtree = rot
+	}
+}
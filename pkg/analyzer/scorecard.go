@@ -0,0 +1,59 @@
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/johnsaigle/go-unmaintained/pkg/parser"
+	"github.com/johnsaigle/go-unmaintained/pkg/scorecard"
+)
+
+// applyScorecard attaches the dependency's OpenSSF Scorecard result and flags an otherwise
+// active dependency scoring below the configured minimums. Checks Scorecard could not
+// evaluate (score -1) never fall below a minimum.
+func (a *Analyzer) applyScorecard(result *Result, dep parser.Dependency) {
+	if a.scorecards == nil {
+		return
+	}
+
+	score, ok := a.lookupScorecard(result, dep)
+	if !ok {
+		return
+	}
+	result.Scorecard = score
+
+	if result.Reason != ReasonActive {
+		return
+	}
+	switch {
+	case a.config.MinMaintainedScore > 0 && score.Maintained >= 0 && score.Maintained < a.config.MinMaintainedScore:
+		result.Details = fmt.Sprintf("OpenSSF Scorecard Maintained check scores %.0f/10, below the minimum of %.0f", score.Maintained, a.config.MinMaintainedScore)
+	case a.config.MinScorecardScore > 0 && score.Aggregate >= 0 && score.Aggregate < a.config.MinScorecardScore:
+		result.Details = fmt.Sprintf("OpenSSF Scorecard score %.1f/10, below the minimum of %.1f", score.Aggregate, a.config.MinScorecardScore)
+	default:
+		return
+	}
+	result.IsUnmaintained = true
+	result.Reason = ReasonLowScorecard
+}
+
+// lookupScorecard finds the Scorecard result for the dependency's repository, by the URL the
+// host reports, then by the module path, then by where the repository moved
+func (a *Analyzer) lookupScorecard(result *Result, dep parser.Dependency) (*scorecard.Score, bool) {
+	var candidates []string
+	if result.RepoInfo != nil && result.RepoInfo.URL != "" {
+		candidates = append(candidates, result.RepoInfo.URL)
+	}
+	if moduleInfo := a.parseModulePath(dep.Path); moduleInfo.IsValid && moduleInfo.Owner != "" {
+		candidates = append(candidates, moduleInfo.Host+"/"+moduleInfo.Owner+"/"+moduleInfo.Repo)
+	}
+	if result.MovedTo != "" {
+		candidates = append(candidates, result.MovedTo)
+	}
+
+	for _, repo := range candidates {
+		if score, ok := a.scorecards.Lookup(repo); ok {
+			return score, true
+		}
+	}
+	return nil, false
+}
@@ -60,13 +60,18 @@ func NewCache(disabled bool, duration time.Duration) (*Cache, error) {
 	}, nil
 }
 
-// GetRepoInfo retrieves cached repository information
+// GetRepoInfo retrieves cached repository information for a github.com repository
 func (c *Cache) GetRepoInfo(owner, repo string) (*types.RepoInfo, string, bool) {
+	return c.GetRepoInfoForHost("github.com", owner, repo)
+}
+
+// GetRepoInfoForHost retrieves cached repository information for a repository on the given host
+func (c *Cache) GetRepoInfoForHost(host, owner, repo string) (*types.RepoInfo, string, bool) {
 	if c.disabled {
 		return nil, "", false
 	}
 
-	cacheKey := fmt.Sprintf("%s_%s", owner, repo)
+	cacheKey := repoCacheKey(host, owner, repo)
 	filePath := c.getCacheFilePath(cacheKey)
 
 	data, err := os.ReadFile(filePath)
@@ -90,8 +95,13 @@ func (c *Cache) GetRepoInfo(owner, repo string) (*types.RepoInfo, string, bool)
 	return entry.RepoInfo, entry.Version, true
 }
 
-// SetRepoInfo stores repository information in cache
+// SetRepoInfo stores repository information for a github.com repository in cache
 func (c *Cache) SetRepoInfo(owner, repo string, repoInfo *types.RepoInfo, latestVersion string) error {
+	return c.SetRepoInfoForHost("github.com", owner, repo, repoInfo, latestVersion)
+}
+
+// SetRepoInfoForHost stores repository information for a repository on the given host in cache
+func (c *Cache) SetRepoInfoForHost(host, owner, repo string, repoInfo *types.RepoInfo, latestVersion string) error {
 	if c.disabled {
 		return nil
 	}
@@ -107,7 +117,7 @@ func (c *Cache) SetRepoInfo(owner, repo string, repoInfo *types.RepoInfo, latest
 		return fmt.Errorf("failed to marshal cache entry: %w", err)
 	}
 
-	cacheKey := fmt.Sprintf("%s_%s", owner, repo)
+	cacheKey := repoCacheKey(host, owner, repo)
 	filePath := c.getCacheFilePath(cacheKey)
 
 	if err := os.WriteFile(filePath, data, 0600); err != nil {
@@ -179,6 +189,15 @@ func getCacheDir() (string, error) {
 	return filepath.Join(homeDir, ".cache", CacheDirName), nil
 }
 
+// repoCacheKey returns the cache key for a repository.
+// github.com keys omit the host so existing cache entries remain valid.
+func repoCacheKey(host, owner, repo string) string {
+	if host == "" || host == "github.com" {
+		return fmt.Sprintf("%s_%s", owner, repo)
+	}
+	return fmt.Sprintf("%s_%s_%s", host, owner, repo)
+}
+
 // getCacheFilePath returns the full path for a cache file
 func (c *Cache) getCacheFilePath(key string) string {
 	// Hash the key to create a safe filename
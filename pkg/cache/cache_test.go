@@ -81,6 +81,36 @@ func TestCache_SetAndGetRepoInfo(t *testing.T) {
 	}
 }
 
+func TestCache_PerHost(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	c, err := NewCache(false, 1*time.Hour)
+	if err != nil {
+		t.Fatalf("NewCache() error: %v", err)
+	}
+
+	public := &types.RepoInfo{Exists: true, URL: "https://github.com/org/repo"}
+	enterprise := &types.RepoInfo{Exists: true, URL: "https://ghe.corp.example/org/repo"}
+
+	if err := c.SetRepoInfo("org", "repo", public, ""); err != nil {
+		t.Fatalf("SetRepoInfo() error: %v", err)
+	}
+	if err := c.SetRepoInfoForHost("ghe.corp.example", "org", "repo", enterprise, ""); err != nil {
+		t.Fatalf("SetRepoInfoForHost() error: %v", err)
+	}
+
+	got, _, hit := c.GetRepoInfoForHost("ghe.corp.example", "org", "repo")
+	if !hit || got.URL != enterprise.URL {
+		t.Errorf("GetRepoInfoForHost(ghe) = %v, %v; want %q", got, hit, enterprise.URL)
+	}
+
+	// github.com entries are shared between the host-aware and legacy accessors
+	got, _, hit = c.GetRepoInfoForHost("github.com", "org", "repo")
+	if !hit || got.URL != public.URL {
+		t.Errorf("GetRepoInfoForHost(github.com) = %v, %v; want %q", got, hit, public.URL)
+	}
+}
+
 func TestCache_Miss(t *testing.T) {
 	t.Setenv("XDG_CACHE_HOME", t.TempDir())
 
@@ -0,0 +1,321 @@
+// Package fixer rewrites a go.mod to move away from unmaintained dependencies: known
+// successors replace flagged direct dependencies, and direct dependencies are upgraded when
+// a newer version no longer needs a flagged indirect one.
+package fixer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
+
+	"github.com/johnsaigle/go-unmaintained/pkg/analyzer"
+	"github.com/johnsaigle/go-unmaintained/pkg/replacements"
+	"github.com/johnsaigle/go-unmaintained/pkg/resolver"
+)
+
+// ChangeKind is the kind of edit made to a requirement
+type ChangeKind string
+
+const (
+	// ChangeReplace swaps a dependency for its successor module
+	ChangeReplace ChangeKind = "replace"
+	// ChangeUpgrade raises a direct dependency to a version that drops unmaintained modules
+	ChangeUpgrade ChangeKind = "upgrade"
+	// ChangeDrop removes an indirect requirement nothing upgraded needs anymore
+	ChangeDrop ChangeKind = "drop"
+)
+
+// Change is one edit to a go.mod requirement
+type Change struct {
+	Kind       ChangeKind
+	Module     string
+	Version    string
+	NewModule  string
+	NewVersion string
+	Reason     string
+}
+
+// String describes the change on one line
+func (c Change) String() string {
+	switch c.Kind {
+	case ChangeReplace:
+		return fmt.Sprintf("replace %s@%s with %s@%s (%s)", c.Module, c.Version, c.NewModule, c.NewVersion, c.Reason)
+	case ChangeUpgrade:
+		return fmt.Sprintf("upgrade %s %s => %s (%s)", c.Module, c.Version, c.NewVersion, c.Reason)
+	default:
+		return fmt.Sprintf("drop %s@%s (%s)", c.Module, c.Version, c.Reason)
+	}
+}
+
+// Plan is the set of edits for one go.mod, with its content before and after them
+type Plan struct {
+	Changes []Change
+	// Manual lists follow-up steps outside go.mod, such as import paths to update
+	Manual   []string
+	Original []byte
+	Updated  []byte
+}
+
+// HasChanges reports whether the plan edits go.mod
+func (p *Plan) HasChanges() bool {
+	return string(p.Original) != string(p.Updated)
+}
+
+// Diff renders the plan's edits to go.mod as a unified diff
+func (p *Plan) Diff() string {
+	return unifiedDiff("a/go.mod", "b/go.mod", p.Original, p.Updated)
+}
+
+// Apply writes the updated go.mod to path, keeping the file's permissions
+func (p *Plan) Apply(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, p.Updated, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// Fixer plans go.mod edits, looking up versions and go.mod files on the module proxy
+type Fixer struct {
+	resolver *resolver.Resolver
+}
+
+// New creates a Fixer that queries the module proxy through moduleResolver
+func New(moduleResolver *resolver.Resolver) *Fixer {
+	return &Fixer{resolver: moduleResolver}
+}
+
+// Plan computes the edits to the go.mod at path, whose content is data, for the given
+// analysis results. Nothing is written; see Plan.Apply.
+func (fx *Fixer) Plan(ctx context.Context, path string, data []byte, results []analyzer.Result) (*Plan, error) {
+	f, err := modfile.Parse(path, data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	flagged := make(map[string]analyzer.Result)
+	for _, result := range results {
+		if result.IsUnmaintained {
+			flagged[result.Package] = result
+		}
+	}
+
+	plan := &Plan{Original: data}
+
+	// Edits update and clear the file's requirements in place, so work from copies
+	requires := make([]modfile.Require, len(f.Require))
+	for i, req := range f.Require {
+		requires[i] = *req
+	}
+
+	// Successors only apply to direct dependencies; indirect ones are imported by other modules
+	for _, req := range requires {
+		if result, ok := flagged[req.Mod.Path]; ok && !req.Indirect {
+			if err := fx.planSuccessor(ctx, f, plan, &req, result); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	unwanted := make(map[string]bool)
+	for _, req := range requires {
+		if _, ok := flagged[req.Mod.Path]; ok && req.Indirect {
+			unwanted[req.Mod.Path] = true
+		}
+	}
+	if len(unwanted) > 0 {
+		if err := fx.planUpgrades(ctx, f, plan, requires, flagged, unwanted); err != nil {
+			return nil, err
+		}
+	}
+
+	f.Cleanup()
+	updated, err := f.Format()
+	if err != nil {
+		return nil, fmt.Errorf("failed to format %s: %w", path, err)
+	}
+	plan.Updated = updated
+	return plan, nil
+}
+
+// planSuccessor replaces a flagged direct dependency with its suggested successor, if the
+// successor is a module the proxy knows. Imports still need updating by hand.
+func (fx *Fixer) planSuccessor(ctx context.Context, f *modfile.File, plan *Plan, req *modfile.Require, result analyzer.Result) error {
+	successor, stdlib := successorModule(result)
+	if stdlib != "" {
+		plan.Manual = append(plan.Manual, fmt.Sprintf("%s: replace its uses with the standard library %s package", req.Mod.Path, stdlib))
+		return nil
+	}
+	if successor == "" {
+		return nil
+	}
+
+	latest, err := fx.resolver.GetLatestInfo(ctx, successor)
+	if err != nil {
+		plan.Manual = append(plan.Manual, fmt.Sprintf("%s: suggested replacement %s is not available from the module proxy", req.Mod.Path, successor))
+		return nil
+	}
+
+	if err := f.DropRequire(req.Mod.Path); err != nil {
+		return fmt.Errorf("failed to drop %s: %w", req.Mod.Path, err)
+	}
+	if !requiresAtLeast(f, successor, latest.Version) {
+		if err := f.AddRequire(successor, latest.Version); err != nil {
+			return fmt.Errorf("failed to require %s: %w", successor, err)
+		}
+	}
+
+	plan.Changes = append(plan.Changes, Change{
+		Kind:       ChangeReplace,
+		Module:     req.Mod.Path,
+		Version:    req.Mod.Version,
+		NewModule:  successor,
+		NewVersion: latest.Version,
+		Reason:     string(result.Reason),
+	})
+	plan.Manual = append(plan.Manual, fmt.Sprintf("%s: update imports to %s", req.Mod.Path, successor))
+	return nil
+}
+
+// planUpgrades upgrades direct dependencies whose latest version no longer requires an
+// unwanted indirect module, then drops the indirect modules no direct dependency requires
+// anymore. Modules needed deeper in the graph are left alone; go mod tidy settles the rest.
+func (fx *Fixer) planUpgrades(ctx context.Context, f *modfile.File, plan *Plan, requires []modfile.Require, flagged map[string]analyzer.Result, unwanted map[string]bool) error {
+	wasRequired := make(map[string]bool)
+	stillRequired := make(map[string]bool)
+
+	for _, req := range requires {
+		if _, isFlagged := flagged[req.Mod.Path]; req.Indirect || isFlagged {
+			continue
+		}
+
+		current, err := fx.resolver.GetModFile(ctx, req.Mod.Path, req.Mod.Version)
+		if err != nil {
+			continue
+		}
+		needs := unwantedRequirements(current, unwanted)
+		if len(needs) == 0 {
+			continue
+		}
+		for _, path := range needs {
+			wasRequired[path] = true
+		}
+
+		remaining, err := fx.planUpgrade(ctx, f, plan, &req, needs)
+		if err != nil {
+			return err
+		}
+		for _, path := range remaining {
+			stillRequired[path] = true
+		}
+	}
+
+	for _, req := range requires {
+		path := req.Mod.Path
+		if !unwanted[path] || !wasRequired[path] || stillRequired[path] {
+			continue
+		}
+		if err := f.DropRequire(path); err != nil {
+			return fmt.Errorf("failed to drop %s: %w", path, err)
+		}
+		plan.Changes = append(plan.Changes, Change{
+			Kind:    ChangeDrop,
+			Module:  path,
+			Version: req.Mod.Version,
+			Reason:  "no longer required by the upgraded dependencies",
+		})
+	}
+	return nil
+}
+
+// planUpgrade upgrades req to its latest version if that drops any of the unwanted modules
+// it needs, and returns the unwanted modules it still needs afterwards
+func (fx *Fixer) planUpgrade(ctx context.Context, f *modfile.File, plan *Plan, req *modfile.Require, needs []string) ([]string, error) {
+	latest, err := fx.resolver.GetLatestInfo(ctx, req.Mod.Path)
+	if err != nil || semver.Compare(latest.Version, req.Mod.Version) <= 0 {
+		return needs, nil
+	}
+	latestMod, err := fx.resolver.GetModFile(ctx, req.Mod.Path, latest.Version)
+	if err != nil {
+		return needs, nil
+	}
+
+	var remaining, dropped []string
+	for _, path := range needs {
+		if requiresModule(latestMod, path) {
+			remaining = append(remaining, path)
+		} else {
+			dropped = append(dropped, path)
+		}
+	}
+	if len(dropped) == 0 {
+		return needs, nil
+	}
+
+	if err := f.AddRequire(req.Mod.Path, latest.Version); err != nil {
+		return nil, fmt.Errorf("failed to upgrade %s: %w", req.Mod.Path, err)
+	}
+	plan.Changes = append(plan.Changes, Change{
+		Kind:       ChangeUpgrade,
+		Module:     req.Mod.Path,
+		Version:    req.Mod.Version,
+		NewModule:  req.Mod.Path,
+		NewVersion: latest.Version,
+		Reason:     "no longer requires " + strings.Join(dropped, ", "),
+	})
+	return remaining, nil
+}
+
+// successorModule returns the module to migrate a flagged dependency to, or the standard
+// library package that replaces it
+func successorModule(result analyzer.Result) (successor, stdlib string) {
+	switch result.SuggestionSource {
+	case "":
+		return "", ""
+	case analyzer.SuggestionCurated:
+		// The suggestion text carries migration notes, so go back to the mapping
+		replacement, ok := replacements.Lookup(result.Package)
+		if !ok {
+			return "", ""
+		}
+		if replacement.Stdlib {
+			return "", replacement.Replacement
+		}
+		return replacement.Replacement, ""
+	default:
+		return result.Suggestion, ""
+	}
+}
+
+// unwantedRequirements returns the unwanted modules a go.mod requires
+func unwantedRequirements(f *modfile.File, unwanted map[string]bool) []string {
+	var needs []string
+	for _, req := range f.Require {
+		if unwanted[req.Mod.Path] {
+			needs = append(needs, req.Mod.Path)
+		}
+	}
+	return needs
+}
+
+// requiresModule reports whether a go.mod requires path at any version
+func requiresModule(f *modfile.File, path string) bool {
+	return slices.ContainsFunc(f.Require, func(req *modfile.Require) bool {
+		return req.Mod.Path == path
+	})
+}
+
+// requiresAtLeast reports whether a go.mod already requires path at version or later
+func requiresAtLeast(f *modfile.File, path, version string) bool {
+	return slices.ContainsFunc(f.Require, func(req *modfile.Require) bool {
+		return req.Mod.Path == path && semver.Compare(req.Mod.Version, version) >= 0
+	})
+}
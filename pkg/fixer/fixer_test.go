@@ -0,0 +1,166 @@
+package fixer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/johnsaigle/go-unmaintained/pkg/analyzer"
+	"github.com/johnsaigle/go-unmaintained/pkg/resolver"
+)
+
+// newLocalProxyResolver lays out files (proxy paths such as example.com/mod/@v/list) in a
+// directory and returns a resolver that serves them as a file:// GOPROXY. Like a GOMODCACHE
+// cache/download tree, it has no @latest files.
+func newLocalProxyResolver(t *testing.T, files map[string]string) *resolver.Resolver {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	moduleResolver := resolver.NewResolver(5 * time.Second)
+	moduleResolver.SetProxyURL("file://" + dir)
+	return moduleResolver
+}
+
+const testGoMod = `module example.com/app
+
+go 1.22
+
+require (
+	example.com/client v1.0.0
+	example.com/keeper v1.0.0
+	github.com/golang/protobuf v1.5.4
+	github.com/pkg/errors v0.9.1
+)
+
+require (
+	example.com/abandoned v0.1.0 // indirect
+	example.com/deep v0.2.0 // indirect
+)
+`
+
+func TestPlan(t *testing.T) {
+	fx := New(newLocalProxyResolver(t, map[string]string{
+		"google.golang.org/protobuf/@v/list":         "v1.36.0\n",
+		"google.golang.org/protobuf/@v/v1.36.0.info": `{"Version":"v1.36.0","Time":"2024-12-01T00:00:00Z"}`,
+		"example.com/client/@v/list":                 "v1.0.0\nv1.2.0\n",
+		"example.com/client/@v/v1.2.0.info":          `{"Version":"v1.2.0","Time":"2024-06-01T00:00:00Z"}`,
+		"example.com/client/@v/v1.0.0.mod":           "module example.com/client\n\nrequire example.com/abandoned v0.1.0\n",
+		"example.com/client/@v/v1.2.0.mod":           "module example.com/client\n",
+		"example.com/keeper/@v/list":                 "v1.0.0\nv1.1.0\n",
+		"example.com/keeper/@v/v1.1.0.info":          `{"Version":"v1.1.0","Time":"2024-06-01T00:00:00Z"}`,
+		"example.com/keeper/@v/v1.0.0.mod":           "module example.com/keeper\n\nrequire example.com/deep v0.2.0\n",
+		"example.com/keeper/@v/v1.1.0.mod":           "module example.com/keeper\n\nrequire example.com/deep v0.2.0\n",
+	}))
+
+	results := []analyzer.Result{
+		{Package: "github.com/golang/protobuf", IsUnmaintained: true, IsDirect: true, Reason: analyzer.ReasonDeprecated, SuggestionSource: analyzer.SuggestionCurated},
+		{Package: "github.com/pkg/errors", IsUnmaintained: true, IsDirect: true, Reason: analyzer.ReasonArchived, SuggestionSource: analyzer.SuggestionCurated},
+		{Package: "example.com/abandoned", IsUnmaintained: true, Reason: analyzer.ReasonStaleInactive},
+		{Package: "example.com/deep", IsUnmaintained: true, Reason: analyzer.ReasonArchived},
+		{Package: "example.com/client", IsDirect: true, Reason: analyzer.ReasonActive},
+	}
+
+	plan, err := fx.Plan(context.Background(), "go.mod", []byte(testGoMod), results)
+	if err != nil {
+		t.Fatalf("Plan() error: %v", err)
+	}
+
+	var changes []string
+	for _, change := range plan.Changes {
+		changes = append(changes, change.String())
+	}
+	want := []string{
+		"replace github.com/golang/protobuf@v1.5.4 with google.golang.org/protobuf@v1.36.0 (module_deprecated)",
+		"upgrade example.com/client v1.0.0 => v1.2.0 (no longer requires example.com/abandoned)",
+		"drop example.com/abandoned@v0.1.0 (no longer required by the upgraded dependencies)",
+	}
+	if strings.Join(changes, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Changes =\n%s\nwant\n%s", strings.Join(changes, "\n"), strings.Join(want, "\n"))
+	}
+
+	manual := strings.Join(plan.Manual, "\n")
+	for _, step := range []string{"github.com/pkg/errors: replace its uses with the standard library errors package", "github.com/golang/protobuf: update imports to google.golang.org/protobuf"} {
+		if !strings.Contains(manual, step) {
+			t.Errorf("Manual = %q, want it to contain %q", manual, step)
+		}
+	}
+
+	updated := string(plan.Updated)
+	for _, line := range []string{"example.com/client v1.2.0", "google.golang.org/protobuf v1.36.0", "github.com/pkg/errors v0.9.1", "example.com/deep v0.2.0 // indirect"} {
+		if !strings.Contains(updated, line) {
+			t.Errorf("updated go.mod is missing %q:\n%s", line, updated)
+		}
+	}
+	for _, line := range []string{"github.com/golang/protobuf", "example.com/abandoned"} {
+		if strings.Contains(updated, line) {
+			t.Errorf("updated go.mod still requires %s:\n%s", line, updated)
+		}
+	}
+}
+
+func TestPlanWithoutFindings(t *testing.T) {
+	fx := New(newLocalProxyResolver(t, nil))
+	plan, err := fx.Plan(context.Background(), "go.mod", []byte(testGoMod), nil)
+	if err != nil {
+		t.Fatalf("Plan() error: %v", err)
+	}
+	if plan.HasChanges() || plan.Diff() != "" {
+		t.Errorf("plan without findings changes go.mod:\n%s", plan.Diff())
+	}
+}
+
+func TestApply(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "go.mod")
+	if err := os.WriteFile(path, []byte(testGoMod), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	plan := &Plan{Original: []byte(testGoMod), Updated: []byte("module example.com/app\n")}
+	if err := plan.Apply(path); err != nil {
+		t.Fatalf("Apply() error: %v", err)
+	}
+	content, _ := os.ReadFile(path)
+	info, _ := os.Stat(path)
+	if string(content) != "module example.com/app\n" || info.Mode().Perm() != 0o644 {
+		t.Errorf("Apply() wrote %q with mode %v", content, info.Mode().Perm())
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	oldText := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\n"
+	newText := "a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\n"
+
+	want := `--- old
++++ new
+@@ -1,5 +1,5 @@
+ a
+-b
++B
+ c
+ d
+ e
+@@ -10,3 +10,4 @@
+ j
+ k
+ l
++m
+`
+	if got := unifiedDiff("old", "new", []byte(oldText), []byte(newText)); got != want {
+		t.Errorf("unifiedDiff() =\n%s\nwant\n%s", got, want)
+	}
+	if got := unifiedDiff("old", "new", []byte(oldText), []byte(oldText)); got != "" {
+		t.Errorf("unifiedDiff() of equal texts = %q, want empty", got)
+	}
+}
@@ -8,6 +8,9 @@ import (
 	"time"
 
 	"github.com/johnsaigle/go-unmaintained/pkg/analyzer"
+	"github.com/johnsaigle/go-unmaintained/pkg/scorecard"
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
+	"github.com/johnsaigle/go-unmaintained/pkg/vulndb"
 )
 
 // ConsoleFormatter formats output for human-readable console display
@@ -77,6 +80,52 @@ func (f *ConsoleFormatter) Format(w io.Writer, results []analyzer.Result, summar
 				fmt.Fprintf(w, "   🔗 %s\n", url)
 			}
 
+			if len(result.Vulnerabilities) > 0 {
+				fmt.Fprintf(w, "   ☣️  Vulnerabilities: %s\n", formatVulnerabilities(result.Vulnerabilities))
+			}
+
+			if result.Scorecard != nil {
+				fmt.Fprintf(w, "   🛡️  OpenSSF Scorecard: %s\n", formatScorecard(result.Scorecard))
+			}
+
+			if result.ModHygiene != nil {
+				fmt.Fprintf(w, "   📄 %s\n", formatModHygiene(result.ModHygiene))
+			}
+
+			if result.ReleasesBehind > 0 {
+				fmt.Fprintf(w, "   📅 %d releases and %d days behind %s\n", result.ReleasesBehind, result.DaysBehind, result.LatestVersion)
+			}
+
+			if result.SupersededBy != "" {
+				fmt.Fprintf(w, "   ⬆️  Newer major version: %s (latest: %s)\n", result.SupersededBy, result.SupersededVersion)
+			}
+
+			// Moves and major versions have their own lines above and below
+			if result.Suggestion != "" && result.Suggestion != result.SupersededBy && result.Suggestion != result.MovedTo && result.Suggestion != result.MovedModule {
+				fmt.Fprintf(w, "   💡 Suggested replacement: %s\n", result.Suggestion)
+			}
+
+			if result.HealthierRepository != "" && result.HealthierRepository != result.Suggestion {
+				fmt.Fprintf(w, "   💡 Consider the parent repository: %s\n", result.HealthierRepository)
+			}
+
+			if result.MovedTo != "" {
+				fmt.Fprintf(w, "   ➡️  Moved to: %s\n", result.MovedTo)
+				if result.MovedModule != "" && result.MovedModule != result.Package {
+					fmt.Fprintf(w, "   New module path: %s\n", result.MovedModule)
+				}
+			}
+
+			// Nested modules are judged by their own directory and tags
+			if sub := result.Submodule; sub != nil {
+				if sub.LastCommitAt != nil {
+					fmt.Fprintf(w, "   Module %s/: last commit %d days ago\n", sub.Dir, int(time.Since(*sub.LastCommitAt).Hours()/24))
+				}
+				if sub.LatestReleaseAt != nil {
+					fmt.Fprintf(w, "   Module release: %s (%d days ago)\n", sub.LatestRelease, int(time.Since(*sub.LatestReleaseAt).Hours()/24))
+				}
+			}
+
 			// Show last activity information with context
 			if result.RepoInfo != nil {
 				if result.RepoInfo.LastCommitAt != nil {
@@ -87,12 +136,50 @@ func (f *ConsoleFormatter) Format(w io.Writer, results []analyzer.Result, summar
 					fmt.Fprintf(w, "   Last activity: %d days ago\n", result.DaysSinceUpdate)
 				}
 
+				if human := result.RepoInfo.LastHumanCommitAt; human != nil && result.RepoInfo.LastCommitAt != nil && !human.Equal(*result.RepoInfo.LastCommitAt) {
+					fmt.Fprintf(w, "   Last human commit: %d days ago\n", int(time.Since(*human).Hours()/24))
+				}
+
+				if result.RepoInfo.LatestReleaseAt != nil && result.RepoInfo.LatestRelease != "" {
+					fmt.Fprintf(w, "   Latest release: %s (%d days ago)\n", result.RepoInfo.LatestRelease, int(time.Since(*result.RepoInfo.LatestReleaseAt).Hours()/24))
+				}
+
+				if parent := result.RepoInfo.Parent; parent != nil && f.opts.Verbose {
+					fmt.Fprintf(w, "   Fork of %s: %d commits ahead, %d behind\n", parent.FullName, parent.AheadBy, parent.BehindBy)
+				}
+
+				if top, share, ok := result.RepoInfo.TopContributor(); ok && f.opts.Verbose {
+					fmt.Fprintf(w, "   Contributors: %d active, top contributor %s with %.0f%% of commits\n", result.ActiveCommitters, top.Login, share*100)
+				}
+
+				if f.opts.Verbose && result.ActivitySource != "" {
+					fmt.Fprintf(w, "   Activity measured by: %s\n", result.ActivitySource)
+				}
+
+				// Forking an abandoned module depends on its license
+				if license := result.RepoInfo.License; license != "" {
+					fmt.Fprintf(w, "   ⚖️  License: %s\n", formatLicense(license))
+				}
+
 				// For archived repos, note that they're archived
 				if result.RepoInfo.IsArchived {
 					fmt.Fprintln(w, "   ⚠️  Repository archived (no new commits possible)")
 				}
 			}
 
+			if result.RepoInfo != nil && result.HealthScore != nil {
+				fmt.Fprintf(w, "   ❤️  Health score: %d/100\n", *result.HealthScore)
+			}
+
+			if r := result.Responsiveness; r != nil && r.Sampled > 0 {
+				fmt.Fprintf(w, "   Maintainer response: %d days median, %d/%d answered (%d open / %d closed)\n",
+					r.MedianResponseDays(), r.Responded, r.Sampled, r.Open, r.Closed)
+			}
+
+			if f.opts.Verbose && len(result.Signals) > 0 {
+				fmt.Fprintf(w, "   Signals: %s\n", formatSignals(result.Signals))
+			}
+
 			// Show dependency path for indirect dependencies
 			if f.opts.ShowPaths && !result.IsDirect && len(result.DependencyPath) > 0 {
 				fmt.Fprintf(w, "   📍 Dependency path: %s\n", strings.Join(result.DependencyPath, " → "))
@@ -110,6 +197,42 @@ func (f *ConsoleFormatter) Format(w io.Writer, results []analyzer.Result, summar
 		fmt.Fprintln(w, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 		for _, result := range unknown {
 			fmt.Fprintf(w, "❓ %s - %s\n", result.Package, result.Details)
+			if f.opts.Verbose && len(result.Signals) > 0 {
+				fmt.Fprintf(w, "   Signals: %s\n", formatSignals(result.Signals))
+			}
+		}
+	}
+
+	var licenseAlerts []analyzer.Result
+	for _, result := range results {
+		if result.LicenseAlert != "" {
+			licenseAlerts = append(licenseAlerts, result)
+		}
+	}
+	if len(licenseAlerts) > 0 {
+		fmt.Fprintf(w, "\n⚖️  LICENSE ALERTS (%d found):\n", len(licenseAlerts))
+		fmt.Fprintln(w, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+		for _, result := range licenseAlerts {
+			switch result.LicenseAlert {
+			case analyzer.LicenseChanged:
+				fmt.Fprintf(w, "⚖️  %s - license changed from %s at %s to %s\n", result.Package, formatLicense(result.PinnedLicense), result.CurrentVersion, formatLicense(result.RepoInfo.License))
+			case analyzer.LicenseMissing:
+				fmt.Fprintf(w, "⚖️  %s - no license; forking it requires the authors' permission\n", result.Package)
+			}
+		}
+	}
+
+	var rollup []analyzer.Result
+	for _, result := range DirectRollup(results) {
+		if len(result.Subtree.Unmaintained) > 0 {
+			rollup = append(rollup, result)
+		}
+	}
+	if len(rollup) > 0 {
+		fmt.Fprintf(w, "\n🌳 UNMAINTAINED MODULES BY DIRECT DEPENDENCY (%d found):\n", len(rollup))
+		fmt.Fprintln(w, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+		for _, result := range rollup {
+			fmt.Fprintf(w, "🌳 %s - %d unmaintained of %d modules: %s\n", result.Package, len(result.Subtree.Unmaintained), result.Subtree.Modules, strings.Join(result.Subtree.Unmaintained, ", "))
 		}
 	}
 
@@ -139,6 +262,14 @@ func (f *ConsoleFormatter) Format(w io.Writer, results []analyzer.Result, summar
 			if url := GetRepositoryURL(result); url != "" {
 				fmt.Fprintf(w, "   🔗 %s\n", url)
 			}
+
+			if len(result.Vulnerabilities) > 0 {
+				fmt.Fprintf(w, "   ☣️  Vulnerabilities: %s\n", formatVulnerabilities(result.Vulnerabilities))
+			}
+
+			if len(result.Signals) > 0 {
+				fmt.Fprintf(w, "   Signals: %s\n", formatSignals(result.Signals))
+			}
 		}
 	}
 
@@ -156,18 +287,45 @@ func (f *ConsoleFormatter) Format(w io.Writer, results []analyzer.Result, summar
 		}
 		fmt.Fprintln(w)
 
+		if summary.UnmaintainedVulnerableCount > 0 {
+			fmt.Fprintf(w, "   ☣️  With unfixed vulnerabilities: %d\n", summary.UnmaintainedVulnerableCount)
+		}
 		if summary.ArchivedCount > 0 {
 			fmt.Fprintf(w, "   📦 Archived repositories: %d\n", summary.ArchivedCount)
 		}
 		if summary.NotFoundCount > 0 {
 			fmt.Fprintf(w, "   🚫 Not found/deleted: %d\n", summary.NotFoundCount)
 		}
+		if summary.MovedCount > 0 {
+			fmt.Fprintf(w, "   ➡️  Moved repositories: %d\n", summary.MovedCount)
+		}
 		if summary.StaleInactiveCount > 0 {
 			fmt.Fprintf(w, "   💤 Stale/Inactive: %d\n", summary.StaleInactiveCount)
 		}
 		if summary.OutdatedCount > 0 {
 			fmt.Fprintf(w, "   📅 Outdated versions: %d\n", summary.OutdatedCount)
 		}
+		if summary.SupersededCount > 0 {
+			fmt.Fprintf(w, "   ⬆️  Superseded by a newer major version: %d\n", summary.SupersededCount)
+		}
+		if summary.DeprecatedCount > 0 {
+			fmt.Fprintf(w, "   ⛔ Deprecated modules: %d\n", summary.DeprecatedCount)
+		}
+		if summary.SelfDeclaredCount > 0 {
+			fmt.Fprintf(w, "   📢 Declared unmaintained by maintainers: %d\n", summary.SelfDeclaredCount)
+		}
+		if summary.NoRecentReleaseCount > 0 {
+			fmt.Fprintf(w, "   🏷️  No recent release: %d\n", summary.NoRecentReleaseCount)
+		}
+		if summary.SoleMaintainerCount > 0 {
+			fmt.Fprintf(w, "   👤 Sole maintainer inactive: %d\n", summary.SoleMaintainerCount)
+		}
+		if summary.UnresponsiveCount > 0 {
+			fmt.Fprintf(w, "   🔕 Unresponsive maintainers: %d\n", summary.UnresponsiveCount)
+		}
+		if summary.LowScorecardCount > 0 {
+			fmt.Fprintf(w, "   🛡️  Below the Scorecard minimum: %d\n", summary.LowScorecardCount)
+		}
 		fmt.Fprintln(w)
 	}
 
@@ -181,6 +339,15 @@ func (f *ConsoleFormatter) Format(w io.Writer, results []analyzer.Result, summar
 		fmt.Fprintln(w, "   (Module authors marked these versions as problematic)")
 	}
 
+	if summary.LicenseChangedCount > 0 || summary.NoLicenseCount > 0 {
+		fmt.Fprintf(w, "\n⚖️  LICENSE ALERTS: %d changed, %d without a license\n", summary.LicenseChangedCount, summary.NoLicenseCount)
+	}
+
+	if summary.VulnerableCount > 0 {
+		fmt.Fprintf(w, "\n☣️  VULNERABLE DEPENDENCIES: %d\n", summary.VulnerableCount)
+		fmt.Fprintln(w, "   (Versions in use are affected by advisories in the vulnerability database)")
+	}
+
 	maintainedCount := summary.TotalDependencies - summary.UnmaintainedCount - summary.UnknownCount
 	if maintainedCount > 0 {
 		fmt.Fprintf(w, "✅ MAINTAINED PACKAGES: %d\n", maintainedCount)
@@ -200,12 +367,18 @@ func getSeverityScore(result analyzer.Result) int {
 	// Priority order:
 	// 1. Direct + Archived (most critical)
 	// 2. Direct + Not Found
-	// 3. Direct + Stale/Inactive
-	// 4. Direct + Outdated
-	// 5. Indirect + Archived
-	// 6. Indirect + Not Found
-	// 7. Indirect + Stale/Inactive
-	// 8. Indirect + Outdated
+	// 3. Direct + Moved
+	// 4. Direct + Deprecated
+	// 5. Direct + Declared unmaintained by its maintainers
+	// 6. Direct + Stale/Inactive
+	// 7. Direct + Sole maintainer inactive
+	// 8. Direct + No recent release
+	// 9. Direct + Unresponsive maintainers
+	// 10. Direct + Superseded by a newer major version
+	// 11. Direct + Below the Scorecard minimum
+	// 12. Direct + Outdated
+	// 13-24. Indirect, in the same order
+	// Unmaintained dependencies with unfixed vulnerabilities come before all others.
 
 	baseScore := 0
 
@@ -215,8 +388,24 @@ func getSeverityScore(result analyzer.Result) int {
 		baseScore = 0
 	case analyzer.ReasonNotFound:
 		baseScore = 10
+	case analyzer.ReasonMoved:
+		baseScore = 12
+	case analyzer.ReasonDeprecated:
+		baseScore = 15
+	case analyzer.ReasonSelfDeclaredUnmaintained:
+		baseScore = 17
 	case analyzer.ReasonStaleInactive:
 		baseScore = 20
+	case analyzer.ReasonSoleMaintainer:
+		baseScore = 22
+	case analyzer.ReasonNoRecentRelease:
+		baseScore = 25
+	case analyzer.ReasonUnresponsive:
+		baseScore = 27
+	case analyzer.ReasonSuperseded:
+		baseScore = 28
+	case analyzer.ReasonLowScorecard:
+		baseScore = 29
 	case analyzer.ReasonOutdated:
 		baseScore = 30
 	default:
@@ -228,5 +417,70 @@ func getSeverityScore(result analyzer.Result) int {
 		baseScore += 50
 	}
 
+	if result.HasUnfixedVulnerability() {
+		baseScore -= 100
+	}
+
 	return baseScore
 }
+
+// formatModHygiene renders the go.mod signals of a dependency's latest version, e.g.
+// "go.mod at v1.4.0: go 1.13, 2/9 requirements unmaintained (rot score 40/100)"
+func formatModHygiene(h *analyzer.ModHygiene) string {
+	goVersion := "no go directive"
+	if h.GoVersion != "" {
+		goVersion = "go " + h.GoVersion
+	}
+	if h.NoModuleSupport {
+		goVersion = "no go.mod"
+	}
+	return fmt.Sprintf("go.mod at %s: %s, %d/%d requirements unmaintained (rot score %d/100)",
+		h.LatestVersion, goVersion, len(h.UnmaintainedRequirements), len(h.Requirements), h.RotScore)
+}
+
+// formatScorecard renders a Scorecard result, e.g. "6.2/10 (Maintained 0/10, 2024-06-01)"
+func formatScorecard(score *scorecard.Score) string {
+	s := fmt.Sprintf("%.1f/10", score.Aggregate)
+	if score.Maintained >= 0 {
+		s += fmt.Sprintf(" (Maintained %.0f/10", score.Maintained)
+	} else {
+		s += " (Maintained not evaluated"
+	}
+	if score.Date != "" {
+		s += ", " + score.Date
+	}
+	return s + ")"
+}
+
+// formatLicense renders an SPDX identifier, spelling out the placeholders hosts report
+func formatLicense(license string) string {
+	switch license {
+	case types.NoLicense:
+		return "none"
+	case "NOASSERTION":
+		return "unrecognized license"
+	}
+	return license
+}
+
+// formatVulnerabilities renders advisories with their fixes, e.g. "GO-2024-0001 (fixed in v1.2.0)"
+func formatVulnerabilities(vulns []vulndb.Vulnerability) string {
+	parts := make([]string, len(vulns))
+	for i, vuln := range vulns {
+		fix := "no fix available"
+		if vuln.Fixed() {
+			fix = "fixed in " + vuln.FixedVersion
+		}
+		parts[i] = fmt.Sprintf("%s (%s)", vuln.ID, fix)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatSignals renders the data sources behind a result, e.g. "github_api, module_proxy"
+func formatSignals(signals []analyzer.Signal) string {
+	names := make([]string, len(signals))
+	for i, signal := range signals {
+		names[i] = string(signal)
+	}
+	return strings.Join(names, ", ")
+}
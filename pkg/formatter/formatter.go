@@ -1,8 +1,10 @@
 package formatter
 
 import (
+	"cmp"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 
 	"github.com/johnsaigle/go-unmaintained/pkg/analyzer"
@@ -73,6 +75,7 @@ func GetRepositoryURL(result analyzer.Result) string {
 		{"github.com/", "https://github.com/%s/%s"},
 		{"gitlab.com/", "https://gitlab.com/%s/%s"},
 		{"bitbucket.org/", "https://bitbucket.org/%s/%s"},
+		{"git.sr.ht/", "https://git.sr.ht/%s/%s"},
 	}
 
 	for _, host := range hosts {
@@ -86,3 +89,21 @@ func GetRepositoryURL(result analyzer.Result) string {
 
 	return ""
 }
+
+// DirectRollup returns the direct dependencies with a subtree rollup, those bringing in the
+// most unmaintained modules first
+func DirectRollup(results []analyzer.Result) []analyzer.Result {
+	var rollup []analyzer.Result
+	for _, result := range results {
+		if result.IsDirect && result.Subtree != nil {
+			rollup = append(rollup, result)
+		}
+	}
+	slices.SortStableFunc(rollup, func(a, b analyzer.Result) int {
+		if c := cmp.Compare(len(b.Subtree.Unmaintained), len(a.Subtree.Unmaintained)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Package, b.Package)
+	})
+	return rollup
+}
@@ -7,24 +7,32 @@ import (
 	"testing"
 
 	"github.com/johnsaigle/go-unmaintained/pkg/analyzer"
+	"github.com/johnsaigle/go-unmaintained/pkg/scorecard"
 	"github.com/johnsaigle/go-unmaintained/pkg/types"
+	"github.com/johnsaigle/go-unmaintained/pkg/vulndb"
 )
 
 // testResults returns a standard set of results for testing formatters
 func testResults() []analyzer.Result {
 	return []analyzer.Result{
 		{
-			Package:         "github.com/archived/repo",
-			IsUnmaintained:  true,
-			IsDirect:        true,
-			Reason:          analyzer.ReasonArchived,
-			Details:         "Repository is archived",
-			CurrentVersion:  "v1.0.0",
-			DaysSinceUpdate: 500,
+			Package:          "github.com/archived/repo",
+			IsUnmaintained:   true,
+			IsDirect:         true,
+			Reason:           analyzer.ReasonArchived,
+			Details:          "Repository is archived",
+			CurrentVersion:   "v1.0.0",
+			DaysSinceUpdate:  500,
+			Suggestion:       "github.com/successor/repo",
+			SuggestionSource: analyzer.SuggestionDescription,
 			RepoInfo: &types.RepoInfo{
 				Exists:     true,
 				IsArchived: true,
 				URL:        "https://github.com/archived/repo",
+				Contributors: []types.Contributor{
+					{Login: "owner", Commits: 90},
+					{Login: "helper", Commits: 10},
+				},
 			},
 		},
 		{
@@ -45,6 +53,7 @@ func testResults() []analyzer.Result {
 			Details:         "Active repository, last updated 5 days ago",
 			CurrentVersion:  "v3.0.0",
 			DaysSinceUpdate: 5,
+			Signals:         []analyzer.Signal{analyzer.SignalGitHubAnonymous, analyzer.SignalModuleProxy},
 		},
 	}
 }
@@ -100,6 +109,10 @@ func TestConsoleFormatter_Format(t *testing.T) {
 		t.Error("output should contain archived package")
 	}
 
+	if !strings.Contains(output, "Suggested replacement: github.com/successor/repo") {
+		t.Error("output should suggest a replacement for the archived package")
+	}
+
 	// Must contain the stale package
 	if !strings.Contains(output, "github.com/stale/repo") {
 		t.Error("output should contain stale package")
@@ -115,6 +128,11 @@ func TestConsoleFormatter_Format(t *testing.T) {
 		t.Error("output should contain dependency path for indirect deps")
 	}
 
+	// Verbose mode labels results with the signals behind them
+	if !strings.Contains(output, "Signals: github_api_unauthenticated, module_proxy") {
+		t.Error("verbose output should list the signals used for each result")
+	}
+
 	// Summary section
 	if !strings.Contains(output, "ANALYSIS SUMMARY") {
 		t.Error("output should contain ANALYSIS SUMMARY")
@@ -161,11 +179,74 @@ func TestConsoleFormatter_FailFast(t *testing.T) {
 	}
 }
 
+func TestConsoleFormatter_Scorecard(t *testing.T) {
+	results := testResults()
+	results[0].Scorecard = &scorecard.Score{Aggregate: 3.2, Maintained: 0, Date: "2026-01-05"}
+
+	fmtr, _ := New("console", Options{})
+	var buf bytes.Buffer
+	if err := fmtr.Format(&buf, results, analyzer.GetSummary(results)); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	line := "OpenSSF Scorecard: 3.2/10 (Maintained 0/10, 2026-01-05)"
+	if n := strings.Count(buf.String(), line); n != 1 {
+		t.Errorf("Scorecard line printed %d times, want once", n)
+	}
+}
+
+func TestConsoleFormatter_HealthScore(t *testing.T) {
+	results := testResults()
+	healthScore := 40
+	// Only the archived result has repository data
+	results[0].HealthScore = &healthScore
+	results[1].HealthScore = &healthScore
+
+	fmtr, _ := New("console", Options{})
+	var buf bytes.Buffer
+	if err := fmtr.Format(&buf, results, analyzer.GetSummary(results)); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	if n := strings.Count(buf.String(), "Health score: 40/100"); n != 1 {
+		t.Errorf("Health score printed %d times, want once for the result with repository data", n)
+	}
+	if strings.Contains(buf.String(), "Health score: 0/100") {
+		t.Error("a result without a health score should not print one")
+	}
+}
+
+func TestConsoleFormatter_ModHygiene(t *testing.T) {
+	results := testResults()
+	results[1].ModHygiene = &analyzer.ModHygiene{
+		LatestVersion:            "v2.1.0",
+		GoVersion:                "1.13",
+		Requirements:             []string{"github.com/archived/repo", "golang.org/x/text"},
+		UnmaintainedRequirements: []string{"github.com/archived/repo"},
+		RotScore:                 30,
+	}
+
+	fmtr, _ := New("console", Options{})
+	var buf bytes.Buffer
+	if err := fmtr.Format(&buf, results, analyzer.GetSummary(results)); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+
+	line := "go.mod at v2.1.0: go 1.13, 1/2 requirements unmaintained (rot score 30/100)"
+	if n := strings.Count(buf.String(), line); n != 1 {
+		t.Errorf("go.mod hygiene line printed %d times, want once", n)
+	}
+}
+
 func TestJSONFormatter_Format(t *testing.T) {
 	fmtr, _ := New("json", Options{})
 	var buf bytes.Buffer
 
-	err := fmtr.Format(&buf, testResults(), testSummary())
+	results := testResults()
+	healthScore := 40
+	results[0].HealthScore = &healthScore
+	results[1].HealthScore = &healthScore
+	err := fmtr.Format(&buf, results, testSummary())
 	if err != nil {
 		t.Fatalf("Format() error: %v", err)
 	}
@@ -199,16 +280,45 @@ func TestJSONFormatter_Format(t *testing.T) {
 			if !r.IsUnmaintained {
 				t.Error("archived repo should be unmaintained")
 			}
+			if r.Suggestion != "github.com/successor/repo" || r.SuggestionSource != "repository_description" {
+				t.Errorf("Suggestion = %q from %q, want github.com/successor/repo from repository_description", r.Suggestion, r.SuggestionSource)
+			}
 			if r.RepoInfo == nil {
 				t.Error("archived repo should have RepoInfo")
 			} else if !r.RepoInfo.IsArchived {
 				t.Error("archived repo RepoInfo.IsArchived should be true")
+			} else if r.RepoInfo.ActiveCommitters == nil || r.RepoInfo.TopContributor != "owner" || r.RepoInfo.TopContributorShare != 0.9 {
+				t.Errorf("contributors = %v active, top %q with %v; want 0 active, owner with 0.9",
+					r.RepoInfo.ActiveCommitters, r.RepoInfo.TopContributor, r.RepoInfo.TopContributorShare)
 			}
 		}
 	}
 	if !found {
 		t.Error("archived repo not found in JSON output")
 	}
+
+	// Health scores come with repository data only
+	for _, r := range output.Results {
+		switch r.Package {
+		case "github.com/archived/repo":
+			if r.HealthScore == nil {
+				t.Error("archived repo should have a health score")
+			}
+		case "github.com/stale/repo":
+			if r.HealthScore != nil {
+				t.Errorf("HealthScore = %d for a result without repository data, want none", *r.HealthScore)
+			}
+		}
+	}
+
+	// Signals are labelled per result
+	for _, r := range output.Results {
+		if r.Package == "github.com/active/repo" {
+			if strings.Join(r.Signals, ",") != "github_api_unauthenticated,module_proxy" {
+				t.Errorf("Signals = %v, want [github_api_unauthenticated module_proxy]", r.Signals)
+			}
+		}
+	}
 }
 
 func TestGitHubActionsFormatter_Format(t *testing.T) {
@@ -236,6 +346,10 @@ func TestGitHubActionsFormatter_Format(t *testing.T) {
 	if !strings.Contains(output, "file=go.mod") {
 		t.Error("output should reference go.mod file")
 	}
+
+	if !strings.Contains(output, "suggested replacement: github.com/successor/repo") {
+		t.Error("output should include the suggested replacement")
+	}
 }
 
 func TestGolangciLintFormatter_Format(t *testing.T) {
@@ -261,6 +375,9 @@ func TestGolangciLintFormatter_Format(t *testing.T) {
 	if !strings.Contains(output, "archived") {
 		t.Error("output should mention archived status")
 	}
+	if !strings.Contains(output, "consider github.com/successor/repo instead") {
+		t.Error("output should include the suggested replacement")
+	}
 }
 
 func TestGolangciLintFormatter_ShouldExit(t *testing.T) {
@@ -331,6 +448,11 @@ func TestGetRepositoryURL(t *testing.T) {
 			result: analyzer.Result{Package: "bitbucket.org/team/lib"},
 			want:   "https://bitbucket.org/team/lib",
 		},
+		{
+			name:   "from SourceHut package path",
+			result: analyzer.Result{Package: "git.sr.ht/~user/lib"},
+			want:   "https://git.sr.ht/~user/lib",
+		},
 		{
 			name:   "unknown host returns empty",
 			result: analyzer.Result{Package: "example.com/pkg"},
@@ -369,11 +491,21 @@ func TestGetSeverityScore(t *testing.T) {
 			result: analyzer.Result{IsDirect: true, Reason: analyzer.ReasonNotFound},
 			want:   10,
 		},
+		{
+			name:   "direct deprecated",
+			result: analyzer.Result{IsDirect: true, Reason: analyzer.ReasonDeprecated},
+			want:   15,
+		},
 		{
 			name:   "direct stale",
 			result: analyzer.Result{IsDirect: true, Reason: analyzer.ReasonStaleInactive},
 			want:   20,
 		},
+		{
+			name:   "direct no recent release",
+			result: analyzer.Result{IsDirect: true, Reason: analyzer.ReasonNoRecentRelease},
+			want:   25,
+		},
 		{
 			name:   "direct outdated",
 			result: analyzer.Result{IsDirect: true, Reason: analyzer.ReasonOutdated},
@@ -389,6 +521,23 @@ func TestGetSeverityScore(t *testing.T) {
 			result: analyzer.Result{IsDirect: false, Reason: analyzer.ReasonStaleInactive},
 			want:   70,
 		},
+		{
+			name:   "direct declared unmaintained",
+			result: analyzer.Result{IsDirect: true, Reason: analyzer.ReasonSelfDeclaredUnmaintained},
+			want:   17,
+		},
+		{
+			name: "indirect stale with unfixed vulnerability (ahead of direct archived)",
+			result: analyzer.Result{IsDirect: false, Reason: analyzer.ReasonStaleInactive,
+				Vulnerabilities: []vulndb.Vulnerability{{ID: "GO-2024-0001", FixedVersion: "v1.2.0"}, {ID: "GO-2024-0002"}}},
+			want: -30,
+		},
+		{
+			name: "direct stale with fixed vulnerability",
+			result: analyzer.Result{IsDirect: true, Reason: analyzer.ReasonStaleInactive,
+				Vulnerabilities: []vulndb.Vulnerability{{ID: "GO-2024-0001", FixedVersion: "v1.2.0"}}},
+			want: 20,
+		},
 	}
 
 	for _, tt := range tests {
@@ -407,3 +556,41 @@ func TestGetSeverityScore(t *testing.T) {
 		t.Error("direct should be more severe (lower score) than indirect for same reason")
 	}
 }
+
+func TestDirectRollup(t *testing.T) {
+	results := testResults()
+	results[0].Subtree = &analyzer.SubtreeRot{Modules: 1}
+	results[1].Subtree = &analyzer.SubtreeRot{Modules: 4, Unmaintained: []string{"github.com/dead/one"}}
+	results[2].Subtree = &analyzer.SubtreeRot{Modules: 6, Unmaintained: []string{"github.com/dead/one", "github.com/stale/repo"}}
+
+	// The indirect github.com/stale/repo is left out; the most unmaintained modules come first
+	rollup := DirectRollup(results)
+	if len(rollup) != 2 || rollup[0].Package != "github.com/active/repo" || rollup[1].Package != "github.com/archived/repo" {
+		t.Fatalf("DirectRollup() = %v, want github.com/active/repo, github.com/archived/repo", rollup)
+	}
+
+	fmtr, _ := New("json", Options{})
+	var buf bytes.Buffer
+	if err := fmtr.Format(&buf, results, analyzer.GetSummary(results)); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+	var output JSONOutput
+	if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if len(output.Rollup) != 2 || output.Rollup[0].UnmaintainedCount != 2 || output.Rollup[0].Modules != 6 {
+		t.Errorf("Rollup = %+v, want github.com/active/repo with 2 of 6 modules first", output.Rollup)
+	}
+
+	buf.Reset()
+	fmtr, _ = New("console", Options{})
+	if err := fmtr.Format(&buf, results, analyzer.GetSummary(results)); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "github.com/active/repo - 2 unmaintained of 6 modules: github.com/dead/one, github.com/stale/repo") {
+		t.Error("console output should list the unmaintained modules of each direct dependency")
+	}
+	if strings.Contains(buf.String(), "github.com/archived/repo - 0 unmaintained") {
+		t.Error("console output should leave out direct dependencies without unmaintained modules")
+	}
+}
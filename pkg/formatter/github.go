@@ -28,9 +28,13 @@ func (f *GitHubActionsFormatter) Format(w io.Writer, results []analyzer.Result,
 
 		// Determine severity
 		severity := "error"
-		if result.Reason == analyzer.ReasonStaleInactive {
+		switch result.Reason {
+		case analyzer.ReasonStaleInactive, analyzer.ReasonSoleMaintainer, analyzer.ReasonNoRecentRelease, analyzer.ReasonUnresponsive, analyzer.ReasonLowScorecard:
 			severity = "warning"
 		}
+		if result.HasUnfixedVulnerability() {
+			severity = "error"
+		}
 
 		// Get URL for reference
 		url := GetRepositoryURL(result)
@@ -40,6 +44,12 @@ func (f *GitHubActionsFormatter) Format(w io.Writer, results []analyzer.Result,
 		if url != "" {
 			message += fmt.Sprintf(" - %s", url)
 		}
+		if len(result.Vulnerabilities) > 0 {
+			message += fmt.Sprintf(" [vulnerabilities: %s]", formatVulnerabilities(result.Vulnerabilities))
+		}
+		if result.Suggestion != "" {
+			message += fmt.Sprintf(" (suggested replacement: %s)", result.Suggestion)
+		}
 
 		// Output annotation
 		// Format: ::{severity} file={name},line={line},title={title}::{message}
@@ -52,6 +62,16 @@ func (f *GitHubActionsFormatter) Format(w io.Writer, results []analyzer.Result,
 		}
 	}
 
+	for _, result := range results {
+		switch result.LicenseAlert {
+		case analyzer.LicenseChanged:
+			fmt.Fprintf(w, "::warning file=go.mod,title=License Changed::%s: license changed from %s at %s to %s\n",
+				result.Package, result.PinnedLicense, result.CurrentVersion, result.RepoInfo.License)
+		case analyzer.LicenseMissing:
+			fmt.Fprintf(w, "::warning file=go.mod,title=No License::%s has no license\n", result.Package)
+		}
+	}
+
 	// Output summary
 	if summary.UnmaintainedCount > 0 {
 		fmt.Fprintf(w, "::warning::Found %d unmaintained packages (%d direct, %d indirect)\n",
@@ -3,8 +3,10 @@ package formatter
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/johnsaigle/go-unmaintained/pkg/analyzer"
+	"golang.org/x/mod/semver"
 )
 
 // GolangciLintFormatter formats output for golangci-lint integration
@@ -41,14 +43,48 @@ func (f *GolangciLintFormatter) formatMessage(result analyzer.Result) string {
 		msg += "the module is archived"
 	case analyzer.ReasonNotFound:
 		msg += "the module was not found"
+	case analyzer.ReasonMoved:
+		msg += fmt.Sprintf("the repository moved to %s", result.MovedTo)
+	case analyzer.ReasonDeprecated:
+		msg += "the module is deprecated"
+	case analyzer.ReasonSelfDeclaredUnmaintained:
+		msg += fmt.Sprintf("its maintainers declare it unmaintained (%q)", result.MaintenanceNotice)
 	case analyzer.ReasonStaleInactive:
 		msg += fmt.Sprintf("the module is inactive for %d days", result.DaysSinceUpdate)
+	case analyzer.ReasonSoleMaintainer:
+		msg += "its sole maintainer has stopped committing"
+	case analyzer.ReasonNoRecentRelease:
+		msg += fmt.Sprintf("the module has had no release for %d days", result.DaysSinceRelease)
+	case analyzer.ReasonUnresponsive:
+		msg += fmt.Sprintf("maintainers take a median of %d days to respond to issues", result.Responsiveness.MedianResponseDays())
+	case analyzer.ReasonSuperseded:
+		msg += fmt.Sprintf("it is superseded by major version %s (%s@%s)", semver.Major(result.SupersededVersion), result.SupersededBy, result.SupersededVersion)
+	case analyzer.ReasonLowScorecard:
+		msg += "its OpenSSF Scorecard score is below the minimum (" + formatScorecard(result.Scorecard) + ")"
 	case analyzer.ReasonOutdated:
-		msg += fmt.Sprintf("version %s is outdated (latest: %s)", result.CurrentVersion, result.LatestVersion)
+		msg += fmt.Sprintf("version %s is outdated (latest: %s, %d releases behind)", result.CurrentVersion, result.LatestVersion, result.ReleasesBehind)
 	default:
 		msg += result.Details
 	}
 
+	var unfixed []string
+	for _, vuln := range result.Vulnerabilities {
+		if !vuln.Fixed() {
+			unfixed = append(unfixed, vuln.ID)
+		}
+	}
+	if len(unfixed) > 0 {
+		msg += fmt.Sprintf("; it has vulnerabilities with no fix available (%s)", strings.Join(unfixed, ", "))
+	}
+	switch result.LicenseAlert {
+	case analyzer.LicenseChanged:
+		msg += fmt.Sprintf("; its license changed from %s to %s", result.PinnedLicense, result.RepoInfo.License)
+	case analyzer.LicenseMissing:
+		msg += "; it has no license"
+	}
+	if result.Suggestion != "" {
+		msg += fmt.Sprintf("; consider %s instead", result.Suggestion)
+	}
 	return msg + "."
 }
 
@@ -18,30 +18,129 @@ type JSONOutput struct {
 	Timestamp time.Time             `json:"timestamp"`
 	Version   string                `json:"version"`
 	Results   []JSONResult          `json:"results"`
+	Rollup    []JSONRollup          `json:"rollup,omitempty"`
 	Summary   analyzer.SummaryStats `json:"summary"`
 }
 
+// JSONRollup represents the unmaintained modules in a direct dependency's subtree in JSON format
+type JSONRollup struct {
+	Package           string   `json:"package"`
+	Unmaintained      []string `json:"unmaintained,omitempty"`
+	Modules           int      `json:"modules"`
+	UnmaintainedCount int      `json:"unmaintained_count"`
+}
+
 // JSONResult represents a single dependency result in JSON format
 type JSONResult struct {
-	RepoInfo        *JSONRepoInfo `json:"repo_info,omitempty"`
-	Package         string        `json:"package"`
-	Reason          string        `json:"reason,omitempty"`
-	Details         string        `json:"details"`
-	CurrentVersion  string        `json:"current_version,omitempty"`
-	LatestVersion   string        `json:"latest_version,omitempty"`
-	DependencyPath  []string      `json:"dependency_path,omitempty"`
-	DaysSinceUpdate int           `json:"days_since_update,omitempty"`
-	IsUnmaintained  bool          `json:"is_unmaintained"`
-	IsDirect        bool          `json:"is_direct"`
+	Responsiveness      *JSONResponsiveness `json:"responsiveness,omitempty"`
+	RepoInfo            *JSONRepoInfo       `json:"repo_info,omitempty"`
+	Submodule           *JSONSubmodule      `json:"submodule,omitempty"`
+	Scorecard           *JSONScorecard      `json:"scorecard,omitempty"`
+	GoMod               *JSONModHygiene     `json:"gomod,omitempty"`
+	Package             string              `json:"package"`
+	Reason              string              `json:"reason,omitempty"`
+	ActivitySource      string              `json:"activity_source,omitempty"`
+	Details             string              `json:"details"`
+	MovedTo             string              `json:"moved_to,omitempty"`
+	MovedModule         string              `json:"moved_module,omitempty"`
+	HealthierRepository string              `json:"healthier_repository,omitempty"`
+	MaintenanceNotice   string              `json:"maintenance_notice,omitempty"`
+	NoticeSource        string              `json:"notice_source,omitempty"`
+	Suggestion          string              `json:"suggestion,omitempty"`
+	PinnedLicense       string              `json:"pinned_license,omitempty"`
+	LicenseAlert        string              `json:"license_alert,omitempty"`
+	SuggestionSource    string              `json:"suggestion_source,omitempty"`
+	SupersededBy        string              `json:"superseded_by,omitempty"`
+	SupersededVersion   string              `json:"superseded_version,omitempty"`
+	CurrentVersion      string              `json:"current_version,omitempty"`
+	LatestVersion       string              `json:"latest_version,omitempty"`
+	DependencyPath      []string            `json:"dependency_path,omitempty"`
+	Vulnerabilities     []JSONVulnerability `json:"vulnerabilities,omitempty"`
+	Signals             []string            `json:"signals,omitempty"`
+	DaysSinceUpdate     int                 `json:"days_since_update,omitempty"`
+	RepoDaysSinceUpdate int                 `json:"repo_days_since_update,omitempty"`
+	ReleasesBehind      int                 `json:"releases_behind,omitempty"`
+	DaysBehind          int                 `json:"days_behind,omitempty"`
+	// HealthScore is set for results with repository data
+	HealthScore    *int `json:"health_score,omitempty"`
+	IsUnmaintained bool `json:"is_unmaintained"`
+	IsDirect       bool `json:"is_direct"`
+	// UnfixedVulnerability is set when an advisory affecting the version in use has no fix
+	UnfixedVulnerability bool `json:"unfixed_vulnerability,omitempty"`
 }
 
 // JSONRepoInfo represents repository information in JSON format
 type JSONRepoInfo struct {
-	CreatedAt      time.Time `json:"created_at,omitempty"`
-	UpdatedAt      time.Time `json:"updated_at,omitempty"`
-	URL            string    `json:"url,omitempty"`
-	LastCommitDays int       `json:"last_commit_days,omitempty"`
-	IsArchived     bool      `json:"is_archived"`
+	CreatedAt           time.Time       `json:"created_at,omitempty"`
+	UpdatedAt           time.Time       `json:"updated_at,omitempty"`
+	PushedAt            *time.Time      `json:"pushed_at,omitempty"`
+	URL                 string          `json:"url,omitempty"`
+	License             string          `json:"license,omitempty"`
+	Topics              []string        `json:"topics,omitempty"`
+	LastCommitDays      int             `json:"last_commit_days,omitempty"`
+	LastHumanCommitDays int             `json:"last_human_commit_days,omitempty"`
+	LatestRelease       string          `json:"latest_release,omitempty"`
+	LatestReleaseDays   int             `json:"latest_release_days,omitempty"`
+	Contributors        int             `json:"contributors,omitempty"`
+	ActiveCommitters    *int            `json:"active_committers,omitempty"`
+	TopContributor      string          `json:"top_contributor,omitempty"`
+	TopContributorShare float64         `json:"top_contributor_share,omitempty"`
+	Parent              *JSONForkParent `json:"parent,omitempty"`
+	IsArchived          bool            `json:"is_archived"`
+	IsFork              bool            `json:"is_fork"`
+}
+
+// JSONSubmodule represents a module nested in a repository subdirectory in JSON format
+type JSONSubmodule struct {
+	Dir                 string `json:"dir"`
+	LatestRelease       string `json:"latest_release,omitempty"`
+	LastCommitDays      int    `json:"last_commit_days,omitempty"`
+	LastHumanCommitDays int    `json:"last_human_commit_days,omitempty"`
+	LatestReleaseDays   int    `json:"latest_release_days,omitempty"`
+}
+
+// JSONForkParent represents a fork's parent repository in JSON format
+type JSONForkParent struct {
+	FullName   string `json:"full_name"`
+	AheadBy    int    `json:"ahead_by"`
+	BehindBy   int    `json:"behind_by"`
+	IsArchived bool   `json:"is_archived"`
+}
+
+// JSONResponsiveness represents sampled maintainer response latency in JSON format
+type JSONResponsiveness struct {
+	MedianResponseHours int `json:"median_response_hours"`
+	Sampled             int `json:"sampled"`
+	Responded           int `json:"responded"`
+	Open                int `json:"open"`
+	Closed              int `json:"closed"`
+}
+
+// JSONModHygiene represents the go.mod of a dependency's latest version in JSON format
+type JSONModHygiene struct {
+	LatestVersion            string   `json:"latest_version"`
+	GoVersion                string   `json:"go_version,omitempty"`
+	UnmaintainedRequirements []string `json:"unmaintained_requirements,omitempty"`
+	Requirements             int      `json:"requirements"`
+	RotScore                 int      `json:"rot_score"`
+	NoModuleSupport          bool     `json:"no_module_support"`
+}
+
+// JSONScorecard represents an OpenSSF Scorecard result in JSON format. Maintained is -1 when
+// Scorecard could not evaluate the check.
+type JSONScorecard struct {
+	Date       string  `json:"date,omitempty"`
+	Score      float64 `json:"score"`
+	Maintained float64 `json:"maintained"`
+}
+
+// JSONVulnerability represents an advisory affecting the version in use in JSON format
+type JSONVulnerability struct {
+	ID           string   `json:"id"`
+	Summary      string   `json:"summary,omitempty"`
+	Aliases      []string `json:"aliases,omitempty"`
+	FixedVersion string   `json:"fixed_version,omitempty"`
+	URL          string   `json:"url,omitempty"`
 }
 
 // Format writes results in JSON format
@@ -50,30 +149,132 @@ func (f *JSONFormatter) Format(w io.Writer, results []analyzer.Result, summary a
 	jsonResults := make([]JSONResult, len(results))
 	for i, result := range results {
 		jsonResult := JSONResult{
-			Package:         result.Package,
-			IsUnmaintained:  result.IsUnmaintained,
-			IsDirect:        result.IsDirect,
-			Reason:          string(result.Reason),
-			Details:         result.Details,
-			CurrentVersion:  result.CurrentVersion,
-			LatestVersion:   result.LatestVersion,
-			DaysSinceUpdate: result.DaysSinceUpdate,
-			DependencyPath:  result.DependencyPath,
+			Package:             result.Package,
+			IsUnmaintained:      result.IsUnmaintained,
+			IsDirect:            result.IsDirect,
+			Reason:              string(result.Reason),
+			ActivitySource:      string(result.ActivitySource),
+			Details:             result.Details,
+			MovedTo:             result.MovedTo,
+			MovedModule:         result.MovedModule,
+			HealthierRepository: result.HealthierRepository,
+			MaintenanceNotice:   result.MaintenanceNotice,
+			NoticeSource:        string(result.NoticeSource),
+			Suggestion:          result.Suggestion,
+			PinnedLicense:       result.PinnedLicense,
+			LicenseAlert:        string(result.LicenseAlert),
+			SuggestionSource:    string(result.SuggestionSource),
+			SupersededBy:        result.SupersededBy,
+			SupersededVersion:   result.SupersededVersion,
+			CurrentVersion:      result.CurrentVersion,
+			LatestVersion:       result.LatestVersion,
+			DaysSinceUpdate:     result.DaysSinceUpdate,
+			RepoDaysSinceUpdate: result.RepoDaysSinceUpdate,
+			ReleasesBehind:      result.ReleasesBehind,
+			DaysBehind:          result.DaysBehind,
+			DependencyPath:      result.DependencyPath,
+		}
+
+		for _, signal := range result.Signals {
+			jsonResult.Signals = append(jsonResult.Signals, string(signal))
+		}
+
+		if h := result.ModHygiene; h != nil {
+			jsonResult.GoMod = &JSONModHygiene{
+				LatestVersion:            h.LatestVersion,
+				GoVersion:                h.GoVersion,
+				UnmaintainedRequirements: h.UnmaintainedRequirements,
+				Requirements:             len(h.Requirements),
+				RotScore:                 h.RotScore,
+				NoModuleSupport:          h.NoModuleSupport,
+			}
+		}
+
+		if result.RepoInfo != nil {
+			jsonResult.HealthScore = result.HealthScore
+		}
+
+		if score := result.Scorecard; score != nil {
+			jsonResult.Scorecard = &JSONScorecard{Date: score.Date, Score: score.Aggregate, Maintained: score.Maintained}
+		}
+
+		for _, vuln := range result.Vulnerabilities {
+			jsonResult.Vulnerabilities = append(jsonResult.Vulnerabilities, JSONVulnerability{
+				ID:           vuln.ID,
+				Summary:      vuln.Summary,
+				Aliases:      vuln.Aliases,
+				FixedVersion: vuln.FixedVersion,
+				URL:          vuln.URL,
+			})
+		}
+		jsonResult.UnfixedVulnerability = result.HasUnfixedVulnerability()
+
+		if r := result.Responsiveness; r != nil {
+			jsonResult.Responsiveness = &JSONResponsiveness{
+				MedianResponseHours: int(r.MedianResponse.Hours()),
+				Sampled:             r.Sampled,
+				Responded:           r.Responded,
+				Open:                r.Open,
+				Closed:              r.Closed,
+			}
+		}
+
+		if sub := result.Submodule; sub != nil {
+			submodule := &JSONSubmodule{Dir: sub.Dir}
+			if sub.LastCommitAt != nil {
+				submodule.LastCommitDays = int(time.Since(*sub.LastCommitAt).Hours() / 24)
+			}
+			if sub.LastHumanCommitAt != nil {
+				submodule.LastHumanCommitDays = int(time.Since(*sub.LastHumanCommitAt).Hours() / 24)
+			}
+			if sub.LatestReleaseAt != nil {
+				submodule.LatestRelease = sub.LatestRelease
+				submodule.LatestReleaseDays = int(time.Since(*sub.LatestReleaseAt).Hours() / 24)
+			}
+			jsonResult.Submodule = submodule
 		}
 
 		// Add repo info if available
 		if result.RepoInfo != nil {
 			repoInfo := &JSONRepoInfo{
 				URL:        result.RepoInfo.URL,
+				License:    result.RepoInfo.License,
+				Topics:     result.RepoInfo.Topics,
 				IsArchived: result.RepoInfo.IsArchived,
+				IsFork:     result.RepoInfo.IsFork,
 				CreatedAt:  result.RepoInfo.CreatedAt,
 				UpdatedAt:  result.RepoInfo.UpdatedAt,
+				PushedAt:   result.RepoInfo.PushedAt,
 			}
 
 			// Calculate days since last commit
 			if result.RepoInfo.LastCommitAt != nil {
 				repoInfo.LastCommitDays = int(time.Since(*result.RepoInfo.LastCommitAt).Hours() / 24)
 			}
+			if result.RepoInfo.LastHumanCommitAt != nil {
+				repoInfo.LastHumanCommitDays = int(time.Since(*result.RepoInfo.LastHumanCommitAt).Hours() / 24)
+			}
+			if result.RepoInfo.LatestReleaseAt != nil {
+				repoInfo.LatestRelease = result.RepoInfo.LatestRelease
+				repoInfo.LatestReleaseDays = int(time.Since(*result.RepoInfo.LatestReleaseAt).Hours() / 24)
+			}
+
+			if top, share, ok := result.RepoInfo.TopContributor(); ok {
+				activeCommitters := result.ActiveCommitters
+				repoInfo.Contributors = len(result.RepoInfo.Contributors)
+				repoInfo.ActiveCommitters = &activeCommitters
+				repoInfo.TopContributor = top.Login
+				repoInfo.TopContributorShare = share
+			}
+
+			if parent := result.RepoInfo.Parent; parent != nil {
+				repoInfo.Parent = &JSONForkParent{
+					FullName:   parent.FullName,
+					AheadBy:    parent.AheadBy,
+					BehindBy:   parent.BehindBy,
+					IsArchived: parent.IsArchived,
+				}
+			}
 
 			jsonResult.RepoInfo = repoInfo
 		}
@@ -81,9 +282,20 @@ func (f *JSONFormatter) Format(w io.Writer, results []analyzer.Result, summary a
 		jsonResults[i] = jsonResult
 	}
 
+	var rollup []JSONRollup
+	for _, result := range DirectRollup(results) {
+		rollup = append(rollup, JSONRollup{
+			Package:           result.Package,
+			Unmaintained:      result.Subtree.Unmaintained,
+			Modules:           result.Subtree.Modules,
+			UnmaintainedCount: len(result.Subtree.Unmaintained),
+		})
+	}
+
 	output := JSONOutput{
 		Summary:   summary,
 		Results:   jsonResults,
+		Rollup:    rollup,
 		Timestamp: time.Now(),
 		Version:   "1.0.0", // Tool version
 	}
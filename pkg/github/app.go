@@ -0,0 +1,205 @@
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"golang.org/x/oauth2"
+)
+
+// AppCredentials identifies a GitHub App installation.
+// Installation tokens are minted from these credentials and refreshed before they expire.
+type AppCredentials struct {
+	APIURL         string // API base URL; defaults to https://api.github.com/
+	PrivateKey     []byte // PEM-encoded RSA private key downloaded from the App settings
+	AppID          int64
+	InstallationID int64
+}
+
+// appJWTLifetime is how long the App JWT used to mint installation tokens is valid.
+// GitHub rejects JWTs that expire more than 10 minutes in the future.
+const appJWTLifetime = 9 * time.Minute
+
+// NewAppClient creates a new GitHub client authenticated as a GitHub App installation
+func NewAppClient(creds AppCredentials) (*Client, error) {
+	baseURL, err := appBaseURL(creds.APIURL)
+	if err != nil {
+		return nil, err
+	}
+
+	ts, err := newAppTokenSource(creds, baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mint the first token up front so credential problems are reported clearly
+	if _, err := ts.Token(); err != nil {
+		return nil, fmt.Errorf("GitHub App authentication failed: %w", err)
+	}
+
+	ghClient := github.NewClient(oauth2.NewClient(context.Background(), ts))
+	if baseURL != nil {
+		ghClient.BaseURL = baseURL
+	}
+	client := &Client{
+		client: ghClient,
+		host:   DefaultHost,
+		isApp:  true,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := client.ValidateToken(ctx); err != nil {
+		return nil, fmt.Errorf("GitHub App token validation failed: %w", err)
+	}
+
+	return client, nil
+}
+
+// appBaseURL parses an optional API base URL, returning nil for the default
+func appBaseURL(apiURL string) (*url.URL, error) {
+	if apiURL == "" {
+		return nil, nil
+	}
+
+	baseURL, err := url.Parse(apiURL)
+	if err != nil || baseURL.Scheme == "" || baseURL.Host == "" {
+		return nil, fmt.Errorf("invalid GitHub API URL %q", apiURL)
+	}
+	if !strings.HasSuffix(baseURL.Path, "/") {
+		baseURL.Path += "/"
+	}
+	return baseURL, nil
+}
+
+// appTokenSource mints GitHub App installation tokens
+type appTokenSource struct {
+	key            *rsa.PrivateKey
+	baseURL        *url.URL // API base URL; nil means api.github.com
+	appID          int64
+	installationID int64
+}
+
+// newAppTokenSource returns a token source that mints installation tokens and
+// reuses each one until shortly before it expires
+func newAppTokenSource(creds AppCredentials, baseURL *url.URL) (oauth2.TokenSource, error) {
+	if creds.AppID <= 0 {
+		return nil, errors.New("GitHub App ID is required")
+	}
+	if creds.InstallationID <= 0 {
+		return nil, errors.New("GitHub App installation ID is required")
+	}
+
+	key, err := parseRSAPrivateKey(creds.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GitHub App private key: %w", err)
+	}
+
+	return oauth2.ReuseTokenSource(nil, &appTokenSource{
+		key:            key,
+		baseURL:        baseURL,
+		appID:          creds.AppID,
+		installationID: creds.InstallationID,
+	}), nil
+}
+
+// Token mints a new installation access token
+func (s *appTokenSource) Token() (*oauth2.Token, error) {
+	jwt, err := s.signJWT(time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign GitHub App JWT: %w", err)
+	}
+
+	appClient := github.NewClient(nil).WithAuthToken(jwt)
+	if s.baseURL != nil {
+		appClient.BaseURL = s.baseURL
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	installationToken, resp, err := appClient.Apps.CreateInstallationToken(ctx, s.installationID, nil)
+	if err != nil {
+		if resp != nil {
+			switch resp.StatusCode {
+			case 401:
+				return nil, fmt.Errorf("GitHub rejected the App JWT (check the app ID and private key): %w", err)
+			case 404:
+				return nil, fmt.Errorf("GitHub App installation %d not found: %w", s.installationID, err)
+			}
+		}
+		return nil, fmt.Errorf("failed to create installation token: %w", err)
+	}
+
+	return &oauth2.Token{
+		AccessToken: installationToken.GetToken(),
+		TokenType:   "Bearer",
+		Expiry:      installationToken.GetExpiresAt().Time,
+	}, nil
+}
+
+// signJWT creates an RS256 JWT identifying the App, as required to mint installation tokens
+func (s *appTokenSource) signJWT(now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+
+	// Backdate issued-at to allow for clock drift between us and GitHub
+	claims, err := json.Marshal(map[string]any{
+		"iat": now.Add(-60 * time.Second).Unix(),
+		"exp": now.Add(appJWTLifetime).Unix(),
+		"iss": strconv.FormatInt(s.appID, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// parseRSAPrivateKey parses a PEM-encoded PKCS#1 or PKCS#8 RSA private key
+func parseRSAPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an RSA key")
+	}
+	return key, nil
+}
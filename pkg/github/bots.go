@@ -0,0 +1,106 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+)
+
+// DefaultBotPatterns match the commit authors of common dependency-update and CI bots
+var DefaultBotPatterns = []string{"[bot]", "dependabot", "renovate", "github-actions"}
+
+// maxCommitPages bounds how far back commit history is searched for a human-authored commit
+const maxCommitPages = 3
+
+// SetBotPatterns replaces the patterns used to recognize bot-authored commits.
+// An empty slice treats every author as human.
+func (c *Client) SetBotPatterns(patterns []string) {
+	c.botPatterns = patterns
+}
+
+// SetHumanCommits chooses whether commit history is searched for the newest commit not
+// authored by a bot, which costs up to maxCommitPages requests per repository or module.
+// It is off by default: only the newest commit is fetched and LastHumanCommitAt stays nil.
+func (c *Client) SetHumanCommits(enabled bool) {
+	c.humanCommits = enabled
+}
+
+// IsBotAuthor reports whether any of the author identities (login, name) contains
+// one of the patterns, ignoring case
+func IsBotAuthor(patterns []string, identities ...string) bool {
+	for _, identity := range identities {
+		identity = strings.ToLower(identity)
+		if identity == "" {
+			continue
+		}
+		for _, pattern := range patterns {
+			if pattern != "" && strings.Contains(identity, strings.ToLower(pattern)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isBot reports whether any of the identities matches the client's bot patterns
+func (c *Client) isBot(identities ...string) bool {
+	patterns := c.botPatterns
+	if patterns == nil {
+		patterns = DefaultBotPatterns
+	}
+	return IsBotAuthor(patterns, identities...)
+}
+
+// isBotCommit reports whether a commit was authored by a bot
+func (c *Client) isBotCommit(commit *github.RepositoryCommit) bool {
+	return c.isBot(commit.GetAuthor().GetLogin(), commit.GetCommit().GetAuthor().GetName())
+}
+
+// fetchCommitActivity records the newest default-branch commit as LastCommitAt and the
+// newest one not authored by a bot as LastHumanCommitAt. Errors are ignored: commit data
+// is an optional refinement of the repository info.
+func (c *Client) fetchCommitActivity(ctx context.Context, owner, repo string, info *RepoInfo) {
+	opts := &github.CommitsListOptions{
+		SHA:         info.DefaultBranch,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	info.LastCommitAt, info.LastHumanCommitAt, _ = c.latestCommits(ctx, owner, repo, opts)
+}
+
+// latestCommits pages through the commits matching opts, newest first, and returns the
+// date of the newest commit and of the newest one not authored by a bot. Whatever was
+// found before an error on a later page is still returned. Unless human commits are searched,
+// only the newest commit is fetched.
+func (c *Client) latestCommits(ctx context.Context, owner, repo string, opts *github.CommitsListOptions) (last, lastHuman *time.Time, err error) {
+	if !c.humanCommits {
+		opts.PerPage = 1
+	}
+	for page := 0; page < maxCommitPages; page++ {
+		commits, resp, listErr := c.client.Repositories.ListCommits(ctx, owner, repo, opts)
+		if listErr != nil {
+			return last, lastHuman, fmt.Errorf("failed to list commits: %w", listErr)
+		}
+
+		for _, commit := range commits {
+			commitDate := commit.GetCommit().GetCommitter().GetDate().Time
+			if last == nil {
+				last = &commitDate
+			}
+			if !c.humanCommits {
+				return last, nil, nil
+			}
+			if !c.isBotCommit(commit) {
+				return last, &commitDate, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return last, lastHuman, nil
+		}
+		opts.Page = resp.NextPage
+	}
+	return last, lastHuman, nil
+}
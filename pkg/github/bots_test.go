@@ -0,0 +1,122 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+)
+
+func TestIsBotAuthor(t *testing.T) {
+	tests := []struct {
+		name       string
+		identities []string
+		want       bool
+	}{
+		{"app suffix", []string{"dependabot[bot]"}, true},
+		{"renovate name", []string{"", "Renovate Bot"}, true},
+		{"actions", []string{"github-actions"}, true},
+		{"human", []string{"octocat", "Mona Lisa"}, false},
+		{"no identity", []string{"", ""}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsBotAuthor(DefaultBotPatterns, tt.identities...); got != tt.want {
+				t.Errorf("IsBotAuthor(%v) = %v, want %v", tt.identities, got, tt.want)
+			}
+		})
+	}
+}
+
+// commitJSON renders a ListCommits entry authored by login, daysAgo days before now
+func commitJSON(login string, daysAgo int) string {
+	date := time.Now().Add(-time.Duration(daysAgo) * 24 * time.Hour).UTC().Format(time.RFC3339)
+	return fmt.Sprintf(`{"author":{"login":%q},"commit":{"author":{"name":%q},"committer":{"date":%q}}}`, login, login, date)
+}
+
+func TestGetRepositoryInfo_SkipsBotCommits(t *testing.T) {
+	var serverURL string
+	server := newEnterpriseTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/org/repo":
+			fmt.Fprint(w, `{"name":"repo","default_branch":"main","pushed_at":"2024-01-02T03:04:05Z"}`)
+		case "/repos/org/repo/commits":
+			if r.URL.Query().Get("sha") != "main" {
+				t.Errorf("commits listed for sha %q, want default branch", r.URL.Query().Get("sha"))
+			}
+			if r.URL.Query().Get("page") == "2" {
+				fmt.Fprintf(w, "[%s,%s]", commitJSON("renovate[bot]", 40), commitJSON("octocat", 500))
+				return
+			}
+			w.Header().Set("Link", fmt.Sprintf(`<%s/repos/org/repo/commits?page=2>; rel="next"`, serverURL))
+			fmt.Fprintf(w, "[%s,%s]", commitJSON("dependabot[bot]", 2), commitJSON("github-actions", 10))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	serverURL = server.URL
+
+	ghClient := github.NewClient(server.Client())
+	ghClient.BaseURL, _ = url.Parse(server.URL + "/")
+	client := &Client{client: ghClient, token: "test", host: DefaultHost}
+	client.SetHumanCommits(true)
+
+	info, err := client.GetRepositoryInfo(context.Background(), "org", "repo")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if info.PushedAt == nil || info.PushedAt.Year() != 2024 {
+		t.Errorf("PushedAt = %v, want 2024-01-02", info.PushedAt)
+	}
+	if info.LastCommitAt == nil || int(time.Since(*info.LastCommitAt).Hours()/24) != 2 {
+		t.Errorf("LastCommitAt = %v, want 2 days ago", info.LastCommitAt)
+	}
+	if info.LastHumanCommitAt == nil || int(time.Since(*info.LastHumanCommitAt).Hours()/24) != 500 {
+		t.Errorf("LastHumanCommitAt = %v, want 500 days ago", info.LastHumanCommitAt)
+	}
+
+	// With no bot patterns every author counts as human
+	client.SetBotPatterns([]string{})
+	info, err = client.GetRepositoryInfo(context.Background(), "org", "repo")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if info.LastHumanCommitAt == nil || !info.LastHumanCommitAt.Equal(*info.LastCommitAt) {
+		t.Errorf("LastHumanCommitAt = %v, want the last commit %v", info.LastHumanCommitAt, info.LastCommitAt)
+	}
+}
+
+func TestGetRepositoryInfo_HumanCommitsOff(t *testing.T) {
+	var requests int
+	client := newTestAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/org/repo":
+			fmt.Fprint(w, `{"name":"repo","default_branch":"main"}`)
+		case "/repos/org/repo/commits":
+			requests++
+			if got := r.URL.Query().Get("per_page"); got != "1" {
+				t.Errorf("per_page = %q, want 1", got)
+			}
+			w.Header().Set("Link", `<http://example.invalid/repos/org/repo/commits?page=2>; rel="next"`)
+			fmt.Fprintf(w, "[%s]", commitJSON("dependabot[bot]", 2))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	info, err := client.GetRepositoryInfo(context.Background(), "org", "repo")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("commit requests = %d, want 1", requests)
+	}
+	if info.LastCommitAt == nil || info.LastHumanCommitAt != nil {
+		t.Errorf("LastCommitAt = %v, LastHumanCommitAt = %v; want only the last commit", info.LastCommitAt, info.LastHumanCommitAt)
+	}
+}
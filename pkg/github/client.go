@@ -4,20 +4,36 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"sort"
-	"strings"
+	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/google/go-github/v82/github"
 	"github.com/johnsaigle/go-unmaintained/pkg/types"
-	"golang.org/x/mod/semver"
 	"golang.org/x/oauth2"
 )
 
+// DefaultHost is the host of the public GitHub instance
+const DefaultHost = "github.com"
+
 // Client wraps the GitHub API client with authentication
 type Client struct {
 	client *github.Client
 	token  string
+	host   string
+	isApp  bool // Authenticated as a GitHub App installation rather than with a token
+
+	botPatterns  []string // Commit authors to ignore when finding the last human commit; nil uses DefaultBotPatterns
+	humanCommits bool     // Search commit history for the last human commit, see SetHumanCommits
+	readme       bool     // Fetch the README header, see SetReadme
+	compareForks bool     // Compare forks with their parent, see SetForkComparison
+}
+
+// EnterpriseHost describes a GitHub Enterprise Server instance
+type EnterpriseHost struct {
+	Host   string // Host used in module paths, e.g. ghe.corp.example
+	APIURL string // API base URL; defaults to https://<Host>/api/v3/
+	Token  string
 }
 
 // RepoInfo is an alias for types.RepoInfo for backward compatibility.
@@ -31,14 +47,61 @@ func NewClient(token string) (*Client, error) {
 		return nil, errors.New("GitHub token is required")
 	}
 
+	return newValidatedClient(github.NewClient(oauthClient(token)), token, DefaultHost)
+}
+
+// NewAnonymousClient creates an unauthenticated client for public github.com data.
+// GitHub allows unauthenticated callers 60 requests per hour, so callers should
+// expect rate limit errors on all but the smallest projects.
+func NewAnonymousClient() *Client {
+	return &Client{
+		client: github.NewClient(&http.Client{Timeout: 30 * time.Second}),
+		host:   DefaultHost,
+	}
+}
+
+// NewEnterpriseClient creates a new authenticated client for a GitHub Enterprise Server instance
+func NewEnterpriseClient(enterprise EnterpriseHost) (*Client, error) {
+	if enterprise.Host == "" {
+		return nil, errors.New("GitHub Enterprise host is required")
+	}
+	if enterprise.Token == "" {
+		return nil, fmt.Errorf("GitHub token is required for %s", enterprise.Host)
+	}
+
+	apiURL := enterprise.APIURL
+	if apiURL == "" {
+		apiURL = "https://" + enterprise.Host
+	}
+
+	parsed, err := url.Parse(apiURL)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid GitHub Enterprise API URL %q", apiURL)
+	}
+	uploadURL := fmt.Sprintf("%s://%s/api/uploads/", parsed.Scheme, parsed.Host)
+
+	ghClient, err := github.NewClient(oauthClient(enterprise.Token)).WithEnterpriseURLs(apiURL, uploadURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure GitHub Enterprise client for %s: %w", enterprise.Host, err)
+	}
+
+	return newValidatedClient(ghClient, enterprise.Token, enterprise.Host)
+}
+
+// oauthClient returns an HTTP client that authenticates with a static token
+func oauthClient(token string) *http.Client {
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: token},
 	)
-	tc := oauth2.NewClient(context.Background(), ts)
+	return oauth2.NewClient(context.Background(), ts)
+}
 
+// newValidatedClient wraps ghClient and validates the token before returning it
+func newValidatedClient(ghClient *github.Client, token, host string) (*Client, error) {
 	client := &Client{
-		client: github.NewClient(tc),
+		client: ghClient,
 		token:  token,
+		host:   host,
 	}
 
 	// Validate the token before returning the client
@@ -46,12 +109,22 @@ func NewClient(token string) (*Client, error) {
 	defer cancel()
 
 	if err := client.ValidateToken(ctx); err != nil {
-		return nil, fmt.Errorf("GitHub token validation failed: %w", err)
+		return nil, fmt.Errorf("GitHub token validation failed for %s: %w", host, err)
 	}
 
 	return client, nil
 }
 
+// Host returns the GitHub host this client talks to
+func (c *Client) Host() string {
+	return c.host
+}
+
+// IsAnonymous reports whether the client makes unauthenticated requests
+func (c *Client) IsAnonymous() bool {
+	return c.token == "" && !c.isApp
+}
+
 // ValidateToken checks if the GitHub token is valid and has necessary permissions
 func (c *Client) ValidateToken(ctx context.Context) error {
 	if c.client == nil {
@@ -60,12 +133,27 @@ func (c *Client) ValidateToken(ctx context.Context) error {
 
 	// Test the token by trying to access a known public repository
 	// This works for both PATs and GITHUB_TOKEN (Actions token)
-	// Unlike Users.Get(), repository access doesn't require user-level permissions
-	_, resp, err := c.client.Repositories.Get(ctx, "golang", "go")
+	// Unlike Users.Get(), repository access doesn't require user-level permissions.
+	// Enterprise instances don't host golang/go, so use the meta endpoint there.
+	// App installation tokens are not tied to a user and may be scoped to
+	// specific repositories, so check them against the rate limit endpoint.
+	var resp *github.Response
+	var err error
+	switch {
+	case c.isApp:
+		_, resp, err = c.client.RateLimit.Get(ctx)
+	case c.host == "" || c.host == DefaultHost:
+		_, resp, err = c.client.Repositories.Get(ctx, "golang", "go")
+	default:
+		_, resp, err = c.client.Meta.Get(ctx)
+	}
 	if err != nil {
 		if resp != nil {
 			switch resp.StatusCode {
 			case 401:
+				if c.isApp {
+					return errors.New("invalid or expired GitHub App installation token")
+				}
 				return errors.New("invalid or expired GitHub token")
 			case 403:
 				if resp.Header.Get("X-RateLimit-Remaining") == "0" {
@@ -124,85 +212,32 @@ func (c *Client) GetRepositoryInfo(ctx context.Context, owner, repo string) (*Re
 	info := &RepoInfo{
 		Exists:        true,
 		IsArchived:    repository.GetArchived(),
+		FullName:      repository.GetFullName(),
 		Description:   repository.GetDescription(),
 		DefaultBranch: repository.GetDefaultBranch(),
+		Topics:        repository.Topics,
+		License:       licenseID(repository.GetLicense()),
 		CreatedAt:     repository.GetCreatedAt().Time,
 		UpdatedAt:     repository.GetUpdatedAt().Time,
 		URL:           repository.GetHTMLURL(),
 	}
 
-	// Get the latest commit date
-	commits, resp, err := c.client.Repositories.ListCommits(ctx, owner, repo, &github.CommitsListOptions{
-		ListOptions: github.ListOptions{PerPage: 1},
-	})
-	if err != nil {
-		// Handle rate limiting for commits API call
-		// Don't fail the entire request for commit info, just skip it
-		_ = resp // Silence unused warning
-	} else if len(commits) > 0 {
-		commitDate := commits[0].GetCommit().GetCommitter().GetDate().Time
-		info.LastCommitAt = &commitDate
+	if pushedAt := repository.GetPushedAt().Time; !pushedAt.IsZero() {
+		info.PushedAt = &pushedAt
 	}
 
-	return info, nil
-}
+	// Get the latest commit dates on the default branch
+	c.fetchCommitActivity(ctx, owner, repo, info)
 
-// GetLatestVersion gets the latest semantic version tag for a repository
-func (c *Client) GetLatestVersion(ctx context.Context, owner, repo string) (string, error) {
-	if c.client == nil {
-		return "", errors.New("GitHub client is nil")
+	// Archived repositories are already known to be unmaintained
+	if !info.IsArchived {
+		c.fetchReadmeHeader(ctx, owner, repo, info)
 	}
 
-	if owner == "" || repo == "" {
-		return "", errors.New("owner and repo name must be provided")
+	if repository.GetFork() && repository.GetParent() != nil {
+		info.IsFork = true
+		c.fetchForkParent(ctx, owner, repository.GetParent(), info)
 	}
 
-	// Get all tags for the repository
-	tags, resp, err := c.client.Repositories.ListTags(ctx, owner, repo, &github.ListOptions{
-		PerPage: 100, // Get up to 100 tags to find semantic versions
-	})
-	if err != nil {
-		if resp != nil {
-			switch resp.StatusCode {
-			case 404:
-				return "", errors.New("repository not found")
-			case 403:
-				if resp.Header.Get("X-RateLimit-Remaining") == "0" {
-					resetTime := resp.Header.Get("X-RateLimit-Reset")
-					return "", fmt.Errorf("GitHub API rate limit exceeded (resets at %s): %w", resetTime, err)
-				}
-				return "", fmt.Errorf("GitHub API access forbidden: %w", err)
-			case 401:
-				return "", fmt.Errorf("GitHub API authentication failed: %w", err)
-			}
-		}
-		return "", fmt.Errorf("failed to fetch repository tags: %w", err)
-	}
-
-	if len(tags) == 0 {
-		return "", errors.New("no tags found in repository")
-	}
-
-	// Extract semantic version tags
-	var validVersions []string
-	for _, tag := range tags {
-		tagName := tag.GetName()
-		// Normalize tag name to semantic version format
-		tagName = strings.TrimPrefix(tagName, "v")
-		if semver.IsValid("v" + tagName) {
-			validVersions = append(validVersions, "v"+tagName)
-		}
-	}
-
-	if len(validVersions) == 0 {
-		// If no semantic versions found, return the latest tag name
-		return tags[0].GetName(), nil
-	}
-
-	// Sort versions and return the latest
-	sort.Slice(validVersions, func(i, j int) bool {
-		return semver.Compare(validVersions[i], validVersions[j]) > 0
-	})
-
-	return validVersions[0], nil
+	return info, nil
 }
@@ -3,6 +3,8 @@ package github
 import (
 	"testing"
 	"time"
+
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
 )
 
 func TestRepoInfo_IsRepositoryActive(t *testing.T) {
@@ -141,6 +143,94 @@ func TestRepoInfo_DaysSinceLastActivity(t *testing.T) {
 	}
 }
 
+func TestRepoInfo_LatestActivity(t *testing.T) {
+	now := time.Now()
+	starred := now.Add(-2 * 24 * time.Hour)
+	pushed := now.Add(-200 * 24 * time.Hour)
+	committed := now.Add(-800 * 24 * time.Hour)
+
+	tests := []struct {
+		name       string
+		info       RepoInfo
+		signals    []types.ActivitySignal
+		wantTime   time.Time
+		wantSource types.ActivitySignal
+	}{
+		{
+			name:       "defaults ignore updated_at bumped by stars",
+			info:       RepoInfo{UpdatedAt: starred, PushedAt: &pushed, LastCommitAt: &committed},
+			signals:    types.DefaultActivitySignals,
+			wantTime:   pushed,
+			wantSource: types.ActivityPushed,
+		},
+		{
+			name:       "last commit only",
+			info:       RepoInfo{UpdatedAt: starred, PushedAt: &pushed, LastCommitAt: &committed},
+			signals:    []types.ActivitySignal{types.ActivityLastCommit},
+			wantTime:   committed,
+			wantSource: types.ActivityLastCommit,
+		},
+		{
+			name:       "updated_at when configured",
+			info:       RepoInfo{UpdatedAt: starred, PushedAt: &pushed, LastCommitAt: &committed},
+			signals:    []types.ActivitySignal{types.ActivityLastCommit, types.ActivityUpdated},
+			wantTime:   starred,
+			wantSource: types.ActivityUpdated,
+		},
+		{
+			// Excluded signals are never used, so the activity is unknown
+			name:    "configured signals unavailable",
+			info:    RepoInfo{UpdatedAt: starred},
+			signals: types.DefaultActivitySignals,
+		},
+		{
+			name:    "human commits configured but not fetched",
+			info:    RepoInfo{UpdatedAt: starred, PushedAt: &pushed, LastCommitAt: &committed},
+			signals: []types.ActivitySignal{types.ActivityLastHumanCommit},
+		},
+		{
+			name:    "no timestamps at all",
+			info:    RepoInfo{},
+			signals: types.DefaultActivitySignals,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotTime, gotSource := tt.info.LatestActivity(tt.signals)
+			if !gotTime.Equal(tt.wantTime) || gotSource != tt.wantSource {
+				t.Errorf("LatestActivity() = %v, %q; want %v, %q", gotTime, gotSource, tt.wantTime, tt.wantSource)
+			}
+		})
+	}
+}
+
+func TestParseActivitySignal(t *testing.T) {
+	if signal, err := types.ParseActivitySignal("pushed_at"); err != nil || signal != types.ActivityPushed {
+		t.Errorf("ParseActivitySignal(pushed_at) = %q, %v", signal, err)
+	}
+	if _, err := types.ParseActivitySignal("stars"); err == nil {
+		t.Error("expected error for unknown signal")
+	}
+}
+
 func timePtr(t time.Time) *time.Time {
 	return &t
 }
+
+func TestNewAnonymousClient(t *testing.T) {
+	client := NewAnonymousClient()
+	if !client.IsAnonymous() {
+		t.Error("NewAnonymousClient() should report IsAnonymous() = true")
+	}
+	if client.Host() != DefaultHost {
+		t.Errorf("Host() = %q, want %q", client.Host(), DefaultHost)
+	}
+
+	if (&Client{token: "ghp_test"}).IsAnonymous() {
+		t.Error("token-authenticated client should not be anonymous")
+	}
+	if (&Client{isApp: true}).IsAnonymous() {
+		t.Error("GitHub App client should not be anonymous")
+	}
+}
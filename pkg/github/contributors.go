@@ -0,0 +1,71 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
+)
+
+// statsAttempts bounds how often contributor statistics are requested while GitHub computes them
+const statsAttempts = 3
+
+// statsRetryDelay is the wait between requests for statistics GitHub is still computing
+var statsRetryDelay = 2 * time.Second
+
+// GetContributors returns the human contributors to the default branch, most commits first,
+// from GitHub's contributor statistics. Statistics cover the top 100 contributors.
+func (c *Client) GetContributors(ctx context.Context, owner, repo string) ([]types.Contributor, error) {
+	if c.client == nil {
+		return nil, errors.New("GitHub client is nil")
+	}
+
+	var stats []*github.ContributorStats
+	for attempt := 1; ; attempt++ {
+		var err error
+		stats, _, err = c.client.Repositories.ListContributorsStats(ctx, owner, repo)
+		if err == nil {
+			break
+		}
+
+		// GitHub answers 202 Accepted while it computes statistics for the first time
+		var accepted *github.AcceptedError
+		if !errors.As(err, &accepted) || attempt == statsAttempts {
+			return nil, fmt.Errorf("failed to fetch contributor statistics: %w", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(statsRetryDelay):
+		}
+	}
+
+	contributors := make([]types.Contributor, 0, len(stats))
+	for _, stat := range stats {
+		login := stat.GetAuthor().GetLogin()
+		if c.isBot(login) {
+			continue
+		}
+
+		contributor := types.Contributor{Login: login, Commits: stat.GetTotal()}
+		for _, week := range stat.Weeks {
+			if week.GetCommits() == 0 {
+				continue
+			}
+			weekStart := week.GetWeek().Time
+			if contributor.LastCommitAt == nil || weekStart.After(*contributor.LastCommitAt) {
+				contributor.LastCommitAt = &weekStart
+			}
+		}
+		contributors = append(contributors, contributor)
+	}
+
+	slices.SortStableFunc(contributors, func(a, b types.Contributor) int {
+		return b.Commits - a.Commits
+	})
+	return contributors, nil
+}
@@ -0,0 +1,50 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGetContributors(t *testing.T) {
+	statsRetryDelay = time.Millisecond
+	t.Cleanup(func() { statsRetryDelay = 2 * time.Second })
+
+	requests := 0
+	client := newTestAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/org/repo/stats/contributors" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		// The first request starts the computation, as on GitHub
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		fmt.Fprint(w, `[
+			{"author":{"login":"helper"},"total":3,"weeks":[{"w":1600000000,"c":3},{"w":1700000000,"c":0}]},
+			{"author":{"login":"dependabot[bot]"},"total":50,"weeks":[{"w":1700000000,"c":50}]},
+			{"author":{"login":"owner"},"total":97,"weeks":[{"w":1500000000,"c":90},{"w":1650000000,"c":7}]}
+		]`)
+	})
+
+	contributors, err := client.GetContributors(context.Background(), "org", "repo")
+	if err != nil {
+		t.Fatalf("GetContributors() error: %v", err)
+	}
+	if len(contributors) != 2 {
+		t.Fatalf("got %d contributors, want 2 (bots excluded): %+v", len(contributors), contributors)
+	}
+	if contributors[0].Login != "owner" || contributors[0].Commits != 97 {
+		t.Errorf("top contributor = %+v, want owner with 97 commits", contributors[0])
+	}
+	if last := contributors[0].LastCommitAt; last == nil || last.Unix() != 1650000000 {
+		t.Errorf("owner LastCommitAt = %v, want the week of 1650000000", last)
+	}
+	if last := contributors[1].LastCommitAt; last == nil || last.Unix() != 1600000000 {
+		t.Errorf("helper LastCommitAt = %v, want the week of 1600000000 (empty weeks ignored)", last)
+	}
+}
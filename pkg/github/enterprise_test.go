@@ -0,0 +1,92 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newEnterpriseTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNewEnterpriseClient(t *testing.T) {
+	server := newEnterpriseTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer ghe-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v3/meta":
+			fmt.Fprint(w, `{"verifiable_password_authentication":true}`)
+		case "/api/v3/repos/org/repo":
+			fmt.Fprint(w, `{"name":"repo","full_name":"org/repo","archived":true,"html_url":"https://ghe.corp.example/org/repo"}`)
+		case "/api/v3/repos/org/repo/commits":
+			fmt.Fprint(w, `[]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client, err := NewEnterpriseClient(EnterpriseHost{
+		Host:   "ghe.corp.example",
+		APIURL: server.URL,
+		Token:  "ghe-token",
+	})
+	if err != nil {
+		t.Fatalf("NewEnterpriseClient() error: %v", err)
+	}
+	if client.Host() != "ghe.corp.example" {
+		t.Errorf("Host() = %q, want %q", client.Host(), "ghe.corp.example")
+	}
+
+	info, err := client.GetRepositoryInfo(context.Background(), "org", "repo")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if !info.Exists || !info.IsArchived {
+		t.Errorf("GetRepositoryInfo() = %+v, want existing archived repository", info)
+	}
+	if info.URL != "https://ghe.corp.example/org/repo" {
+		t.Errorf("URL = %q, want %q", info.URL, "https://ghe.corp.example/org/repo")
+	}
+}
+
+func TestNewEnterpriseClient_InvalidToken(t *testing.T) {
+	server := newEnterpriseTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := NewEnterpriseClient(EnterpriseHost{
+		Host:   "ghe.corp.example",
+		APIURL: server.URL + "/api/v3/",
+		Token:  "bad-token",
+	})
+	if err == nil {
+		t.Error("expected error for invalid token")
+	}
+}
+
+func TestNewEnterpriseClient_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		host EnterpriseHost
+	}{
+		{"missing host", EnterpriseHost{Token: "x"}},
+		{"missing token", EnterpriseHost{Host: "ghe.corp.example"}},
+		{"invalid API URL", EnterpriseHost{Host: "ghe.corp.example", APIURL: "not a url", Token: "x"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewEnterpriseClient(tt.host); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
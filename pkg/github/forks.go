@@ -0,0 +1,42 @@
+package github
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
+)
+
+// SetForkComparison chooses whether GetRepositoryInfo compares forks with their parent to
+// fill in AheadBy and BehindBy, at the cost of a request per fork. It is off by default.
+func (c *Client) SetForkComparison(enabled bool) {
+	c.compareForks = enabled
+}
+
+// fetchForkParent records a fork's parent repository and, if enabled with SetForkComparison,
+// how far the fork's default branch has diverged from the parent's. The comparison is
+// optional: if it fails, only the parent's own details are recorded.
+func (c *Client) fetchForkParent(ctx context.Context, owner string, parent *github.Repository, info *RepoInfo) {
+	info.Parent = &types.ForkParent{
+		FullName:   parent.GetFullName(),
+		IsArchived: parent.GetArchived(),
+	}
+	if pushedAt := parent.GetPushedAt().Time; !pushedAt.IsZero() {
+		info.Parent.PushedAt = &pushedAt
+	}
+
+	parentOwner, parentRepo, ok := strings.Cut(parent.GetFullName(), "/")
+	if !c.compareForks || !ok || info.DefaultBranch == "" {
+		return
+	}
+
+	// Cross-repository comparisons name the head as owner:branch
+	comparison, _, err := c.client.Repositories.CompareCommits(ctx, parentOwner, parentRepo,
+		parent.GetDefaultBranch(), owner+":"+info.DefaultBranch, &github.ListOptions{PerPage: 1})
+	if err != nil {
+		return
+	}
+	info.Parent.AheadBy = comparison.GetAheadBy()
+	info.Parent.BehindBy = comparison.GetBehindBy()
+}
@@ -0,0 +1,49 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGetRepositoryInfo_Fork(t *testing.T) {
+	client := newTestAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/me/lib":
+			fmt.Fprint(w, `{"full_name":"me/lib","default_branch":"main","fork":true,
+				"parent":{"full_name":"upstream/lib","default_branch":"master","archived":true,"pushed_at":"2020-01-02T00:00:00Z"}}`)
+		case "/repos/me/lib/commits":
+			fmt.Fprint(w, `[]`)
+		case "/repos/upstream/lib/compare/master...me:main":
+			fmt.Fprint(w, `{"ahead_by":12,"behind_by":3}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	client.SetForkComparison(true)
+
+	info, err := client.GetRepositoryInfo(context.Background(), "me", "lib")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if !info.IsFork || info.Parent == nil {
+		t.Fatalf("IsFork = %v, Parent = %v; want a fork with its parent", info.IsFork, info.Parent)
+	}
+	if info.Parent.FullName != "upstream/lib" || !info.Parent.IsArchived || info.Parent.PushedAt == nil {
+		t.Errorf("Parent = %+v, want archived upstream/lib with pushed_at", info.Parent)
+	}
+	if info.Parent.AheadBy != 12 || info.Parent.BehindBy != 3 {
+		t.Errorf("AheadBy = %d, BehindBy = %d; want 12, 3", info.Parent.AheadBy, info.Parent.BehindBy)
+	}
+
+	// Without the comparison only the parent's own details are recorded
+	client.SetForkComparison(false)
+	info, err = client.GetRepositoryInfo(context.Background(), "me", "lib")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if info.Parent == nil || info.Parent.FullName != "upstream/lib" || info.Parent.AheadBy != 0 || info.Parent.BehindBy != 0 {
+		t.Errorf("Parent = %+v, want upstream/lib without a comparison", info.Parent)
+	}
+}
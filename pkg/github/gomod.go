@@ -0,0 +1,32 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"golang.org/x/mod/modfile"
+)
+
+// GetModulePath returns the module path declared by the go.mod in dir (relative to the
+// repository root) on the default branch, or "" if the file has no module directive.
+func (c *Client) GetModulePath(ctx context.Context, owner, repo, dir string) (string, error) {
+	if c.client == nil {
+		return "", errors.New("GitHub client is nil")
+	}
+
+	file, _, _, err := c.client.Repositories.GetContents(ctx, owner, repo, path.Join(dir, "go.mod"), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch go.mod: %w", err)
+	}
+	if file == nil {
+		return "", fmt.Errorf("go.mod in %s/%s is not a file", owner, repo)
+	}
+
+	content, err := file.GetContent()
+	if err != nil {
+		return "", fmt.Errorf("failed to decode go.mod: %w", err)
+	}
+	return modfile.ModulePath([]byte(content)), nil
+}
@@ -0,0 +1,35 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGetModulePath(t *testing.T) {
+	client := newTestAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/new/lib/contents/go.mod":
+			content := base64.StdEncoding.EncodeToString([]byte("module github.com/new/lib\n\ngo 1.21\n"))
+			fmt.Fprintf(w, `{"type":"file","encoding":"base64","content":%q}`, content)
+		case "/repos/new/lib/contents/sub/go.mod":
+			content := base64.StdEncoding.EncodeToString([]byte("module github.com/old/lib/sub\n"))
+			fmt.Fprintf(w, `{"type":"file","encoding":"base64","content":%q}`, content)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	ctx := context.Background()
+
+	if got, err := client.GetModulePath(ctx, "new", "lib", ""); err != nil || got != "github.com/new/lib" {
+		t.Errorf("GetModulePath(root) = %q, %v; want github.com/new/lib", got, err)
+	}
+	if got, err := client.GetModulePath(ctx, "new", "lib", "sub"); err != nil || got != "github.com/old/lib/sub" {
+		t.Errorf("GetModulePath(sub) = %q, %v; want github.com/old/lib/sub", got, err)
+	}
+	if _, err := client.GetModulePath(ctx, "new", "lib", "missing"); err == nil {
+		t.Error("GetModulePath() for a missing go.mod should fail")
+	}
+}
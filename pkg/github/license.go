@@ -0,0 +1,46 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
+)
+
+// licenseID returns the SPDX identifier of a license GitHub detected, types.NoLicense if it
+// found none, or "NOASSERTION" for a license file it could not identify
+func licenseID(license *github.License) string {
+	if license == nil {
+		return types.NoLicense
+	}
+	return license.GetSPDXID()
+}
+
+// GetLicense returns the SPDX identifier of the license GitHub detects in the repository at
+// ref, a tag, branch or commit. It is types.NoLicense if the tree at ref has no license file.
+func (c *Client) GetLicense(ctx context.Context, owner, repo, ref string) (string, error) {
+	if c.client == nil {
+		return "", errors.New("GitHub client is nil")
+	}
+
+	// go-github's License method has no ref parameter
+	u := fmt.Sprintf("repos/%s/%s/license?ref=%s", owner, repo, url.QueryEscape(ref))
+	req, err := c.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return "", err
+	}
+
+	license := &github.RepositoryLicense{}
+	resp, err := c.client.Do(ctx, req, license)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return types.NoLicense, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch license at %s: %w", ref, err)
+	}
+	return licenseID(license.License), nil
+}
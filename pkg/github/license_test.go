@@ -0,0 +1,48 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
+)
+
+func TestGetLicense(t *testing.T) {
+	client := newTestAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/repos/org/repo":
+			fmt.Fprint(w, `{"full_name":"org/repo","default_branch":"main","license":{"key":"bsl-1.1","spdx_id":"BUSL-1.1"}}`)
+		case r.URL.Path == "/repos/org/repo/license" && r.URL.Query().Get("ref") == "sub/v1.2.0":
+			fmt.Fprint(w, `{"path":"LICENSE","license":{"key":"apache-2.0","spdx_id":"Apache-2.0"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	info, err := client.GetRepositoryInfo(context.Background(), "org", "repo")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if info.License != "BUSL-1.1" {
+		t.Errorf("License = %q, want BUSL-1.1", info.License)
+	}
+
+	tests := []struct {
+		ref  string
+		want string
+	}{
+		{"sub/v1.2.0", "Apache-2.0"},
+		{"v0.1.0", types.NoLicense},
+	}
+	for _, tt := range tests {
+		license, err := client.GetLicense(context.Background(), "org", "repo", tt.ref)
+		if err != nil {
+			t.Fatalf("GetLicense(%s) error: %v", tt.ref, err)
+		}
+		if license != tt.want {
+			t.Errorf("GetLicense(%s) = %q, want %q", tt.ref, license, tt.want)
+		}
+	}
+}
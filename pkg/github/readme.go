@@ -0,0 +1,50 @@
+package github
+
+import (
+	"context"
+	"strings"
+)
+
+// maxReadmeHeaderLines bounds the part of a README kept as its header, where projects put
+// banners such as "This project is no longer maintained"
+const maxReadmeHeaderLines = 40
+
+// maxReadmeHeaderBytes bounds the header of READMEs with very long lines
+const maxReadmeHeaderBytes = 4096
+
+// SetReadme chooses whether GetRepositoryInfo fetches the README header, which is only
+// needed to look for maintenance notices. It is off by default.
+func (c *Client) SetReadme(enabled bool) {
+	c.readme = enabled
+}
+
+// fetchReadmeHeader records the beginning of the repository's README. The README costs a
+// request, so it is skipped unless enabled with SetReadme and for unauthenticated clients;
+// errors, including a missing README, are ignored as the header is an optional refinement
+// of the repository info.
+func (c *Client) fetchReadmeHeader(ctx context.Context, owner, repo string, info *RepoInfo) {
+	if !c.readme || c.IsAnonymous() {
+		return
+	}
+
+	readme, _, err := c.client.Repositories.GetReadme(ctx, owner, repo, nil)
+	if err != nil || readme == nil {
+		return
+	}
+	content, err := readme.GetContent()
+	if err != nil {
+		return
+	}
+	info.ReadmeHeader = readmeHeader(content)
+}
+
+// readmeHeader returns the first maxReadmeHeaderLines lines of a README, cut to at most
+// maxReadmeHeaderBytes
+func readmeHeader(content string) string {
+	lines := strings.SplitN(content, "\n", maxReadmeHeaderLines+1)
+	header := strings.Join(lines[:min(len(lines), maxReadmeHeaderLines)], "\n")
+	if len(header) > maxReadmeHeaderBytes {
+		header = strings.ToValidUTF8(header[:maxReadmeHeaderBytes], "")
+	}
+	return header
+}
@@ -0,0 +1,49 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetRepositoryInfo_TopicsAndReadme(t *testing.T) {
+	readme := "# lib\n\n> **Warning**\n> This project is no longer maintained.\n" + strings.Repeat("more\n", 100)
+	client := newTestAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/org/repo":
+			fmt.Fprint(w, `{"full_name":"org/repo","default_branch":"main","topics":["go","deprecated"]}`)
+		case "/repos/org/repo/readme":
+			fmt.Fprintf(w, `{"type":"file","encoding":"base64","content":%q}`, base64.StdEncoding.EncodeToString([]byte(readme)))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	client.SetReadme(true)
+
+	info, err := client.GetRepositoryInfo(context.Background(), "org", "repo")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if len(info.Topics) != 2 || info.Topics[1] != "deprecated" {
+		t.Errorf("Topics = %v, want [go deprecated]", info.Topics)
+	}
+	if !strings.Contains(info.ReadmeHeader, "no longer maintained") {
+		t.Errorf("ReadmeHeader = %q, want the maintenance banner", info.ReadmeHeader)
+	}
+	if lines := strings.Count(info.ReadmeHeader, "\n") + 1; lines != maxReadmeHeaderLines {
+		t.Errorf("ReadmeHeader has %d lines, want %d", lines, maxReadmeHeaderLines)
+	}
+
+	// Without notice checks the README is not requested
+	client.SetReadme(false)
+	info, err = client.GetRepositoryInfo(context.Background(), "org", "repo")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if info.ReadmeHeader != "" {
+		t.Errorf("ReadmeHeader = %q, want it skipped", info.ReadmeHeader)
+	}
+}
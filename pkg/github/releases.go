@@ -0,0 +1,89 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"golang.org/x/mod/semver"
+)
+
+// maxTagPages bounds how many pages of tags are searched for the highest version tag
+const maxTagPages = 5
+
+// GetLatestRelease returns the tag and publication date of the newest published release.
+// Repositories that only push tags fall back to the highest semantic version tag, dated
+// by its commit. The date is nil if the repository has neither releases nor version tags.
+func (c *Client) GetLatestRelease(ctx context.Context, owner, repo string) (string, *time.Time, error) {
+	if c.client == nil {
+		return "", nil, errors.New("GitHub client is nil")
+	}
+
+	release, resp, err := c.client.Repositories.GetLatestRelease(ctx, owner, repo)
+	if err == nil {
+		publishedAt := release.GetPublishedAt().Time
+		if publishedAt.IsZero() {
+			publishedAt = release.GetCreatedAt().Time
+		}
+		return release.GetTagName(), &publishedAt, nil
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		return "", nil, fmt.Errorf("failed to fetch latest release: %w", err)
+	}
+
+	// No releases; look for version tags instead
+	return c.latestVersionTag(ctx, owner, repo, "")
+}
+
+// GetLatestModuleTag returns the highest dir/vX.Y.Z tag, the versions of the module nested
+// in dir, dated by its commit. GitHub releases are repository-wide, so only tags are used.
+// The date is nil if the module has no version tags.
+func (c *Client) GetLatestModuleTag(ctx context.Context, owner, repo, dir string) (string, *time.Time, error) {
+	if c.client == nil {
+		return "", nil, errors.New("GitHub client is nil")
+	}
+	return c.latestVersionTag(ctx, owner, repo, dir+"/")
+}
+
+// latestVersionTag returns the tag with the highest semantic version after prefix, dated by
+// its commit, looking at up to maxTagPages pages of tags
+func (c *Client) latestVersionTag(ctx context.Context, owner, repo, prefix string) (string, *time.Time, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	var latest *github.RepositoryTag
+	var latestVersion string
+	for page := 0; page < maxTagPages; page++ {
+		tags, resp, err := c.client.Repositories.ListTags(ctx, owner, repo, opts)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to fetch repository tags: %w", err)
+		}
+
+		for _, tag := range tags {
+			version, ok := strings.CutPrefix(tag.GetName(), prefix)
+			if !ok || !semver.IsValid(version) {
+				continue
+			}
+			if latest == nil || semver.Compare(version, latestVersion) > 0 {
+				latest, latestVersion = tag, version
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	if latest == nil {
+		return "", nil, nil
+	}
+
+	commit, _, err := c.client.Repositories.GetCommit(ctx, owner, repo, latest.GetCommit().GetSHA(), nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to fetch commit for tag %s: %w", latest.GetName(), err)
+	}
+	taggedAt := commit.GetCommit().GetCommitter().GetDate().Time
+	return latest.GetName(), &taggedAt, nil
+}
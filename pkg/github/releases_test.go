@@ -0,0 +1,91 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v82/github"
+)
+
+// newTestAPIClient returns a token client whose API base URL is an httptest server running handler
+func newTestAPIClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := newEnterpriseTestServer(t, handler)
+
+	ghClient := github.NewClient(server.Client())
+	ghClient.BaseURL, _ = url.Parse(server.URL + "/")
+	return &Client{client: ghClient, token: "test", host: DefaultHost}
+}
+
+func TestGetLatestRelease(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		wantTag  string
+		wantYear int
+	}{
+		{
+			name: "published release",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/repos/org/repo/releases/latest" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				fmt.Fprint(w, `{"tag_name":"v2.1.0","published_at":"2021-06-01T00:00:00Z"}`)
+			},
+			wantTag:  "v2.1.0",
+			wantYear: 2021,
+		},
+		{
+			name: "tags only",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/repos/org/repo/tags":
+					fmt.Fprint(w, `[{"name":"nightly","commit":{"sha":"aaa"}},{"name":"v1.10.0","commit":{"sha":"bbb"}},{"name":"v1.9.0","commit":{"sha":"ccc"}}]`)
+				case "/repos/org/repo/commits/bbb":
+					fmt.Fprint(w, `{"sha":"bbb","commit":{"committer":{"date":"2019-02-03T00:00:00Z"}}}`)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			},
+			wantTag:  "v1.10.0",
+			wantYear: 2019,
+		},
+		{
+			name: "no releases or version tags",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/repos/org/repo/tags" {
+					fmt.Fprint(w, `[{"name":"nightly","commit":{"sha":"aaa"}}]`)
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestAPIClient(t, tt.handler)
+
+			tag, releasedAt, err := client.GetLatestRelease(context.Background(), "org", "repo")
+			if err != nil {
+				t.Fatalf("GetLatestRelease() error: %v", err)
+			}
+			if tag != tt.wantTag {
+				t.Errorf("tag = %q, want %q", tag, tt.wantTag)
+			}
+			if tt.wantYear == 0 {
+				if releasedAt != nil {
+					t.Errorf("releasedAt = %v, want nil", releasedAt)
+				}
+				return
+			}
+			if releasedAt == nil || releasedAt.Year() != tt.wantYear {
+				t.Errorf("releasedAt = %v, want year %d", releasedAt, tt.wantYear)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
+)
+
+// DefaultResponsivenessSample is how many recent issues and pull requests are sampled
+const DefaultResponsivenessSample = 20
+
+// maintainerAssociations are the author associations GitHub reports for people with write access
+var maintainerAssociations = map[string]bool{"OWNER": true, "MEMBER": true, "COLLABORATOR": true}
+
+// GetResponsiveness samples up to sampleSize of the most recent issues and pull requests
+// opened by people other than maintainers and bots, and measures how long maintainers took
+// to respond. A maintainer comment or a maintainer closing the item counts as a response.
+func (c *Client) GetResponsiveness(ctx context.Context, owner, repo string, sampleSize int) (*types.Responsiveness, error) {
+	if c.client == nil {
+		return nil, errors.New("GitHub client is nil")
+	}
+
+	// The issues API returns pull requests too
+	issues, _, err := c.client.Issues.ListByRepo(ctx, owner, repo, &github.IssueListByRepoOptions{
+		State:       "all",
+		Sort:        "created",
+		Direction:   "desc",
+		ListOptions: github.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issues: %w", err)
+	}
+
+	info := &types.Responsiveness{}
+	var latencies []time.Duration
+	for _, issue := range issues {
+		if info.Sampled >= sampleSize {
+			break
+		}
+		if maintainerAssociations[issue.GetAuthorAssociation()] || c.isBot(issue.GetUser().GetLogin()) {
+			continue
+		}
+
+		latency, responded, latencyErr := c.responseLatency(ctx, owner, repo, issue)
+		if latencyErr != nil {
+			return nil, latencyErr
+		}
+
+		info.Sampled++
+		latencies = append(latencies, latency)
+		if responded {
+			info.Responded++
+		}
+		if issue.GetState() == "closed" {
+			info.Closed++
+		} else {
+			info.Open++
+		}
+	}
+
+	info.MedianResponse = median(latencies)
+	return info, nil
+}
+
+// responseLatency returns how long an issue waited for its first maintainer response and
+// whether it got one. Issues still waiting report their age so far.
+func (c *Client) responseLatency(ctx context.Context, owner, repo string, issue *github.Issue) (time.Duration, bool, error) {
+	createdAt := issue.GetCreatedAt().Time
+	var respondedAt time.Time
+
+	if issue.GetComments() > 0 {
+		comments, _, err := c.client.Issues.ListComments(ctx, owner, repo, issue.GetNumber(), &github.IssueListCommentsOptions{
+			Sort:        github.Ptr("created"),
+			Direction:   github.Ptr("asc"),
+			ListOptions: github.ListOptions{PerPage: 100},
+		})
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to list comments on #%d: %w", issue.GetNumber(), err)
+		}
+
+		for _, comment := range comments {
+			if !maintainerAssociations[comment.GetAuthorAssociation()] || c.isBot(comment.GetUser().GetLogin()) {
+				continue
+			}
+			respondedAt = comment.GetCreatedAt().Time
+			break
+		}
+	}
+
+	if closedAt := issue.GetClosedAt().Time; !closedAt.IsZero() && (respondedAt.IsZero() || closedAt.Before(respondedAt)) {
+		byMaintainer, err := c.closedByMaintainer(ctx, owner, repo, issue)
+		if err != nil {
+			return 0, false, err
+		}
+		if byMaintainer {
+			respondedAt = closedAt
+		}
+	}
+
+	if respondedAt.IsZero() {
+		return time.Since(createdAt), false, nil
+	}
+	return respondedAt.Sub(createdAt), true, nil
+}
+
+// closedByMaintainer reports whether an issue was last closed by someone other than its
+// author or a bot. Only people with triage access can close others' issues, so the closer
+// is a maintainer; authors closing their own issues and stale bots have not responded.
+func (c *Client) closedByMaintainer(ctx context.Context, owner, repo string, issue *github.Issue) (bool, error) {
+	// closed_by is only reported for single issues, so listed ones need their events
+	closer := issue.GetClosedBy().GetLogin()
+	if closer == "" {
+		events, _, err := c.client.Issues.ListIssueEvents(ctx, owner, repo, issue.GetNumber(), &github.ListOptions{PerPage: 100})
+		if err != nil {
+			return false, fmt.Errorf("failed to list events on #%d: %w", issue.GetNumber(), err)
+		}
+		for _, event := range events {
+			if event.GetEvent() == "closed" {
+				closer = event.GetActor().GetLogin()
+			}
+		}
+	}
+	return closer != "" && closer != issue.GetUser().GetLogin() && !c.isBot(closer), nil
+}
+
+// median returns the middle value of durations, or zero if there are none
+func median(durations []time.Duration) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
@@ -0,0 +1,117 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// issueJSON renders a ListByRepo entry opened daysAgo days before now
+func issueJSON(number int, login, association, state string, daysAgo, comments int) string {
+	created := time.Now().Add(-time.Duration(daysAgo) * 24 * time.Hour).UTC().Format(time.RFC3339)
+	return fmt.Sprintf(`{"number":%d,"user":{"login":%q},"author_association":%q,"state":%q,"created_at":%q,"comments":%d}`,
+		number, login, association, state, created, comments)
+}
+
+func TestGetResponsiveness(t *testing.T) {
+	client := newTestAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/org/repo/issues":
+			fmt.Fprintf(w, "[%s,%s,%s,%s,%s]",
+				issueJSON(5, "alice", "NONE", "open", 10, 0),
+				issueJSON(4, "maintainer", "OWNER", "open", 11, 0),
+				issueJSON(3, "dependabot[bot]", "NONE", "open", 12, 0),
+				issueJSON(2, "bob", "CONTRIBUTOR", "open", 30, 2),
+				issueJSON(1, "carol", "NONE", "closed", 40, 1))
+		case "/repos/org/repo/issues/2/comments":
+			answered := time.Now().Add(-26 * 24 * time.Hour).UTC().Format(time.RFC3339)
+			fmt.Fprintf(w, `[{"user":{"login":"bob"},"author_association":"CONTRIBUTOR","created_at":%q},{"user":{"login":"maintainer"},"author_association":"OWNER","created_at":%q}]`, answered, answered)
+		case "/repos/org/repo/issues/1/comments":
+			answered := time.Now().Add(-38 * 24 * time.Hour).UTC().Format(time.RFC3339)
+			fmt.Fprintf(w, `[{"user":{"login":"dave"},"author_association":"MEMBER","created_at":%q}]`, answered)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	info, err := client.GetResponsiveness(context.Background(), "org", "repo", DefaultResponsivenessSample)
+	if err != nil {
+		t.Fatalf("GetResponsiveness() error: %v", err)
+	}
+
+	// Maintainer- and bot-opened items are skipped
+	if info.Sampled != 3 || info.Responded != 2 {
+		t.Errorf("Sampled = %d, Responded = %d; want 3, 2", info.Sampled, info.Responded)
+	}
+	if info.Open != 2 || info.Closed != 1 {
+		t.Errorf("Open = %d, Closed = %d; want 2, 1", info.Open, info.Closed)
+	}
+	// Latencies are 2, 4 and 10 (still waiting) days
+	if days := info.MedianResponseDays(); days != 4 {
+		t.Errorf("MedianResponseDays() = %d, want 4", days)
+	}
+}
+
+func TestGetResponsiveness_Closes(t *testing.T) {
+	daysAgo := func(days int) string {
+		return time.Now().Add(-time.Duration(days) * 24 * time.Hour).UTC().Format(time.RFC3339)
+	}
+	// closedIssueJSON renders an issue opened by carol 30 days ago and closed closedDaysAgo days ago
+	closedIssueJSON := func(number, closedDaysAgo int) string {
+		return fmt.Sprintf(`{"number":%d,"user":{"login":"carol"},"author_association":"NONE","state":"closed","created_at":%q,"closed_at":%q}`,
+			number, daysAgo(30), daysAgo(closedDaysAgo))
+	}
+	closedEventJSON := func(actor string) string {
+		return fmt.Sprintf(`[{"event":"labeled","actor":{"login":"maintainer"}},{"event":"closed","actor":{"login":%q}}]`, actor)
+	}
+
+	client := newTestAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/org/repo/issues":
+			fmt.Fprintf(w, "[%s,%s,%s]", closedIssueJSON(3, 28), closedIssueJSON(2, 29), closedIssueJSON(1, 20))
+		case "/repos/org/repo/issues/3/events":
+			fmt.Fprint(w, closedEventJSON("maintainer"))
+		case "/repos/org/repo/issues/2/events":
+			fmt.Fprint(w, closedEventJSON("carol"))
+		case "/repos/org/repo/issues/1/events":
+			fmt.Fprint(w, closedEventJSON("stale[bot]"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	info, err := client.GetResponsiveness(context.Background(), "org", "repo", DefaultResponsivenessSample)
+	if err != nil {
+		t.Fatalf("GetResponsiveness() error: %v", err)
+	}
+
+	// Only the maintainer's close is a response; the others waited their whole 30 days
+	if info.Sampled != 3 || info.Responded != 1 || info.Closed != 3 {
+		t.Errorf("Sampled = %d, Responded = %d, Closed = %d; want 3, 1, 3", info.Sampled, info.Responded, info.Closed)
+	}
+	if days := info.MedianResponseDays(); days != 30 {
+		t.Errorf("MedianResponseDays() = %d, want 30", days)
+	}
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name      string
+		durations []time.Duration
+		want      time.Duration
+	}{
+		{"empty", nil, 0},
+		{"odd", []time.Duration{3, 1, 2}, 2},
+		{"even", []time.Duration{4, 1, 2, 3}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := median(tt.durations); got != tt.want {
+				t.Errorf("median(%v) = %v, want %v", tt.durations, got, tt.want)
+			}
+		})
+	}
+}
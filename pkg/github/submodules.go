@@ -0,0 +1,28 @@
+package github
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
+)
+
+// GetModuleActivity returns the newest commits on branch that touch dir, the directory of a
+// nested module. Releases are left to GetLatestModuleTag.
+func (c *Client) GetModuleActivity(ctx context.Context, owner, repo, branch, dir string) (*types.ModuleActivity, error) {
+	if c.client == nil {
+		return nil, errors.New("GitHub client is nil")
+	}
+
+	opts := &github.CommitsListOptions{
+		SHA:         branch,
+		Path:        dir,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	last, lastHuman, err := c.latestCommits(ctx, owner, repo, opts)
+	if err != nil && last == nil {
+		return nil, err
+	}
+	return &types.ModuleActivity{Dir: dir, LastCommitAt: last, LastHumanCommitAt: lastHuman}, nil
+}
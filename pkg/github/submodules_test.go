@@ -0,0 +1,59 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGetModuleActivity(t *testing.T) {
+	client := newTestAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/org/repo/commits" || r.URL.Query().Get("path") != "sub" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `[
+			{"sha":"b","author":{"login":"renovate[bot]"},"commit":{"committer":{"date":"2024-05-01T00:00:00Z"}}},
+			{"sha":"a","author":{"login":"alice"},"commit":{"committer":{"date":"2021-03-01T00:00:00Z"}}}
+		]`)
+	})
+	client.SetHumanCommits(true)
+
+	activity, err := client.GetModuleActivity(context.Background(), "org", "repo", "main", "sub")
+	if err != nil {
+		t.Fatalf("GetModuleActivity() error: %v", err)
+	}
+	if activity.Dir != "sub" || activity.LastCommitAt == nil || activity.LastCommitAt.Year() != 2024 {
+		t.Errorf("Dir = %q, LastCommitAt = %v; want sub, 2024", activity.Dir, activity.LastCommitAt)
+	}
+	if activity.LastHumanCommitAt == nil || activity.LastHumanCommitAt.Year() != 2021 {
+		t.Errorf("LastHumanCommitAt = %v, want 2021", activity.LastHumanCommitAt)
+	}
+}
+
+func TestGetLatestModuleTag(t *testing.T) {
+	client := newTestAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/org/repo/tags":
+			fmt.Fprint(w, `[
+				{"name":"v3.0.0","commit":{"sha":"root"}},
+				{"name":"sub/v1.10.0","commit":{"sha":"newest"}},
+				{"name":"sub/v1.9.0","commit":{"sha":"older"}},
+				{"name":"subtle/v2.0.0","commit":{"sha":"other"}}
+			]`)
+		case "/repos/org/repo/commits/newest":
+			fmt.Fprint(w, `{"sha":"newest","commit":{"committer":{"date":"2022-02-01T00:00:00Z"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	tag, taggedAt, err := client.GetLatestModuleTag(context.Background(), "org", "repo", "sub")
+	if err != nil {
+		t.Fatalf("GetLatestModuleTag() error: %v", err)
+	}
+	if tag != "sub/v1.10.0" || taggedAt == nil || taggedAt.Year() != 2022 {
+		t.Errorf("GetLatestModuleTag() = %q, %v; want sub/v1.10.0 from 2022", tag, taggedAt)
+	}
+}
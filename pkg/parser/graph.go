@@ -0,0 +1,107 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// ModuleGraph is the requirement graph of a module, as printed by go mod graph
+type ModuleGraph struct {
+	// requires maps a module version ("path@version", or the main module's path) to the
+	// module versions it requires
+	requires map[string][]module.Version
+	// selected is the version minimal version selection picks for each module path: the
+	// highest version in the graph
+	selected map[string]string
+}
+
+// GetModuleGraph runs go mod graph in projectPath
+func GetModuleGraph(ctx context.Context, projectPath string) (*ModuleGraph, error) {
+	cmd := exec.CommandContext(ctx, "go", "mod", "graph")
+	cmd.Dir = projectPath
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to run go mod graph: %w", err)
+	}
+	return ParseModuleGraph(bytes.NewReader(output))
+}
+
+// ParseModuleGraph reads go mod graph output: one "module@version requirement@version" edge
+// per line, where the main module has no version
+func ParseModuleGraph(r io.Reader) (*ModuleGraph, error) {
+	graph := &ModuleGraph{
+		requires: make(map[string][]module.Version),
+		selected: make(map[string]string),
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		from, to := splitModuleVersion(fields[0]), splitModuleVersion(fields[1])
+		if to.Path == "go" || to.Path == "toolchain" {
+			// Go version requirements, not modules
+			continue
+		}
+		graph.requires[from.String()] = append(graph.requires[from.String()], to)
+		graph.selectVersion(from)
+		graph.selectVersion(to)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read module graph: %w", err)
+	}
+	return graph, nil
+}
+
+// Subtree returns the paths of the modules modulePath pulls into the build, directly or
+// through other modules, sorted. Each module is followed at its selected version, and
+// modulePath itself is left out.
+func (g *ModuleGraph) Subtree(modulePath string) []string {
+	version, ok := g.selected[modulePath]
+	if !ok {
+		return nil
+	}
+
+	seen := map[string]bool{modulePath: true}
+	queue := []module.Version{{Path: modulePath, Version: version}}
+	var subtree []string
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, req := range g.requires[current.String()] {
+			if seen[req.Path] {
+				continue
+			}
+			seen[req.Path] = true
+			subtree = append(subtree, req.Path)
+			queue = append(queue, module.Version{Path: req.Path, Version: g.selected[req.Path]})
+		}
+	}
+	slices.Sort(subtree)
+	return subtree
+}
+
+// selectVersion records m's version if it is the highest seen for its path
+func (g *ModuleGraph) selectVersion(m module.Version) {
+	if current, ok := g.selected[m.Path]; !ok || semver.Compare(m.Version, current) > 0 {
+		g.selected[m.Path] = m.Version
+	}
+}
+
+// splitModuleVersion splits "path@version"; the main module has no version
+func splitModuleVersion(s string) module.Version {
+	path, version, _ := strings.Cut(s, "@")
+	return module.Version{Path: path, Version: version}
+}
@@ -0,0 +1,42 @@
+package parser
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestModuleGraph_Subtree(t *testing.T) {
+	graph, err := ParseModuleGraph(strings.NewReader(`example.com/main example.com/a@v1.0.0
+example.com/main example.com/b@v1.2.0
+example.com/main go@1.22
+example.com/a@v1.0.0 example.com/dead@v0.1.0
+example.com/a@v1.0.0 example.com/b@v1.1.0
+example.com/b@v1.1.0 example.com/old@v1.0.0
+example.com/b@v1.2.0 example.com/c@v1.0.0
+example.com/c@v1.0.0 example.com/a@v1.0.0
+go@1.22 toolchain@go1.22
+`))
+	if err != nil {
+		t.Fatalf("ParseModuleGraph() error: %v", err)
+	}
+
+	tests := []struct {
+		module string
+		want   []string
+	}{
+		// b is followed at its selected v1.2.0, so example.com/old is not in the build
+		{"example.com/a", []string{"example.com/b", "example.com/c", "example.com/dead"}},
+		{"example.com/b", []string{"example.com/a", "example.com/c", "example.com/dead"}},
+		{"example.com/dead", nil},
+		{"example.com/unknown", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.module, func(t *testing.T) {
+			if got := graph.Subtree(tt.module); !slices.Equal(got, tt.want) {
+				t.Errorf("Subtree(%s) = %v, want %v", tt.module, got, tt.want)
+			}
+		})
+	}
+}
@@ -96,9 +96,13 @@ func IsValidModulePath(path string) bool {
 
 // ModuleInfo contains parsed information about a module
 type ModuleInfo struct {
-	Host        string
-	Owner       string
-	Repo        string
+	Host  string
+	Owner string
+	Repo  string
+	// Subdir is the repository directory of a nested module, without any major version
+	// suffix, e.g. "sub" for github.com/org/repo/sub/v2; empty for a repository's root module.
+	// GitLab subgroups make the repository root ambiguous, so it is only set for GitHub.
+	Subdir      string
 	IsGitHub    bool
 	IsKnownHost bool
 	IsValid     bool
@@ -128,21 +132,35 @@ func ParseModulePath(path string) *ModuleInfo {
 		if len(parts) >= 3 {
 			info.Owner = parts[1]
 			info.Repo = parts[2]
+			info.Subdir = ModuleSubdir(path)
 		}
-	case "gitlab.com", "bitbucket.org":
+	case "gitlab.com", "bitbucket.org", "git.sr.ht":
 		info.IsKnownHost = true
 		if len(parts) >= 3 {
 			info.Owner = parts[1]
 			info.Repo = parts[2]
 		}
 	default:
+		// Gitiles hosts serve repositories directly under the host, e.g. go.googlesource.com/crypto
+		if strings.HasSuffix(info.Host, ".googlesource.com") {
+			info.IsKnownHost = true
+			if len(parts) >= 2 {
+				info.Repo = parts[1]
+			}
+			break
+		}
+
 		// Handle special cases for well-known Go modules
 		if isWellKnownGoModule(path) {
 			info.IsKnownHost = true
 		}
 
-		// Try to extract owner/repo for generic hosts
-		if len(parts) >= 3 {
+		// Try to extract owner/repo for generic hosts. Bitbucket Server serves
+		// repositories as /scm/<project>/<repo>.git, so skip the scm segment.
+		if len(parts) >= 4 && parts[1] == "scm" {
+			info.Owner = parts[2]
+			info.Repo = strings.TrimSuffix(parts[3], ".git")
+		} else if len(parts) >= 3 {
 			info.Owner = parts[1]
 			info.Repo = parts[2]
 		}
@@ -151,6 +169,20 @@ func ParseModulePath(path string) *ModuleInfo {
 	return info
 }
 
+// ModuleSubdir returns the repository directory of a module on a host/owner/repo layout,
+// without any major version suffix: "sub" for github.com/org/repo/sub/v2, and "" for a
+// repository's root module
+func ModuleSubdir(path string) string {
+	if prefix, _, ok := module.SplitPathVersion(path); ok {
+		path = prefix
+	}
+	parts := strings.SplitN(path, "/", 4)
+	if len(parts) < 4 {
+		return ""
+	}
+	return parts[3]
+}
+
 // isWellKnownGoModule checks if the module is a well-known Go module.
 // Delegates to types.IsWellKnownModule for the canonical implementation.
 func isWellKnownGoModule(path string) bool {
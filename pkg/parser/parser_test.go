@@ -27,6 +27,32 @@ func TestParseModulePath(t *testing.T) {
 		{
 			name: "GitHub module with sub-package",
 			path: "github.com/user/repo/pkg/sub",
+			expected: ModuleInfo{
+				Host:        "github.com",
+				Owner:       "user",
+				Repo:        "repo",
+				Subdir:      "pkg/sub",
+				IsGitHub:    true,
+				IsKnownHost: true,
+				IsValid:     true,
+			},
+		},
+		{
+			name: "GitHub nested module with major version",
+			path: "github.com/user/repo/sub/v2",
+			expected: ModuleInfo{
+				Host:        "github.com",
+				Owner:       "user",
+				Repo:        "repo",
+				Subdir:      "sub",
+				IsGitHub:    true,
+				IsKnownHost: true,
+				IsValid:     true,
+			},
+		},
+		{
+			name: "GitHub root module with major version",
+			path: "github.com/user/repo/v3",
 			expected: ModuleInfo{
 				Host:        "github.com",
 				Owner:       "user",
@@ -60,6 +86,30 @@ func TestParseModulePath(t *testing.T) {
 				IsValid:     true,
 			},
 		},
+		{
+			name: "SourceHut module",
+			path: "git.sr.ht/~user/lib",
+			expected: ModuleInfo{
+				Host:        "git.sr.ht",
+				Owner:       "~user",
+				Repo:        "lib",
+				IsGitHub:    false,
+				IsKnownHost: true,
+				IsValid:     true,
+			},
+		},
+		{
+			name: "Gitiles module (repository directly under host)",
+			path: "go.googlesource.com/crypto",
+			expected: ModuleInfo{
+				Host:        "go.googlesource.com",
+				Owner:       "",
+				Repo:        "crypto",
+				IsGitHub:    false,
+				IsKnownHost: true,
+				IsValid:     true,
+			},
+		},
 		{
 			name: "golang.org/x module",
 			path: "golang.org/x/crypto",
@@ -120,6 +170,18 @@ func TestParseModulePath(t *testing.T) {
 				IsValid:     true,
 			},
 		},
+		{
+			name: "Bitbucket Server scm path",
+			path: "bitbucket.corp.example/scm/proj/lib.git",
+			expected: ModuleInfo{
+				Host:        "bitbucket.corp.example",
+				Owner:       "proj",
+				Repo:        "lib",
+				IsGitHub:    false,
+				IsKnownHost: false,
+				IsValid:     true,
+			},
+		},
 		{
 			name: "unknown host with owner/repo",
 			path: "example.com/org/repo",
@@ -146,6 +208,9 @@ func TestParseModulePath(t *testing.T) {
 			if result.Repo != tt.expected.Repo {
 				t.Errorf("Repo = %q, want %q", result.Repo, tt.expected.Repo)
 			}
+			if result.Subdir != tt.expected.Subdir {
+				t.Errorf("Subdir = %q, want %q", result.Subdir, tt.expected.Subdir)
+			}
 			if result.IsGitHub != tt.expected.IsGitHub {
 				t.Errorf("IsGitHub = %v, want %v", result.IsGitHub, tt.expected.IsGitHub)
 			}
@@ -0,0 +1,165 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
+)
+
+// BitbucketServerProvider handles self-hosted Bitbucket Server and Data Center
+// instances, addressed by their base URL (e.g. https://bitbucket.corp.example).
+// Repositories are identified by project key (owner) and repository slug (repo).
+type BitbucketServerProvider struct {
+	httpClient *http.Client
+	baseURL    string
+	host       string
+	token      string
+}
+
+// BitbucketServerRepository represents a Bitbucket Server repository response
+type BitbucketServerRepository struct {
+	Slug        string `json:"slug"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Project     struct {
+		Key string `json:"key"`
+	} `json:"project"`
+	Links struct {
+		Self []struct {
+			Href string `json:"href"`
+		} `json:"self"`
+	} `json:"links"`
+	Archived bool `json:"archived"`
+}
+
+// BitbucketServerCommits represents a page of the Bitbucket Server commits endpoint
+type BitbucketServerCommits struct {
+	Values []struct {
+		ID                 string `json:"id"`
+		CommitterTimestamp int64  `json:"committerTimestamp"`
+	} `json:"values"`
+}
+
+// BitbucketServerBranch represents the default branch response
+type BitbucketServerBranch struct {
+	ID        string `json:"id"`
+	DisplayID string `json:"displayId"`
+}
+
+// NewBitbucketServerProvider creates a provider for the Bitbucket Server instance at baseURL.
+// The token is sent as a bearer token (HTTP access token or personal access token)
+// and may be empty for instances that allow anonymous access.
+func NewBitbucketServerProvider(baseURL, token string) (*BitbucketServerProvider, error) {
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Bitbucket Server URL %q: %w", baseURL, err)
+	}
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid Bitbucket Server URL %q: scheme and host are required", baseURL)
+	}
+
+	return &BitbucketServerProvider{
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		host:    parsed.Host,
+		token:   token,
+	}, nil
+}
+
+// GetName returns the provider name
+func (bp *BitbucketServerProvider) GetName() string {
+	return "Bitbucket Server"
+}
+
+// SupportsHost checks if this provider supports the given host
+func (bp *BitbucketServerProvider) SupportsHost(host string) bool {
+	return host == bp.host
+}
+
+// GetRepositoryInfo fetches repository information from Bitbucket Server
+func (bp *BitbucketServerProvider) GetRepositoryInfo(ctx context.Context, owner, repo string) (*types.RepoInfo, error) {
+	if owner == "" || repo == "" {
+		return nil, fmt.Errorf("project key and repository slug must be provided")
+	}
+
+	repoURL := fmt.Sprintf("%s/rest/api/1.0/projects/%s/repos/%s",
+		bp.baseURL, url.PathEscape(owner), url.PathEscape(strings.TrimSuffix(repo, ".git")))
+
+	var repository BitbucketServerRepository
+	status, err := bp.getJSON(ctx, repoURL, &repository)
+	if err != nil {
+		return nil, err
+	}
+	if status == 404 {
+		return &types.RepoInfo{Exists: false}, nil
+	}
+	if status == 401 || status == 403 {
+		return nil, fmt.Errorf("bitbucket Server API access denied (status %d, check your token)", status)
+	}
+	if status != 200 {
+		return nil, fmt.Errorf("bitbucket Server API returned status %d", status)
+	}
+
+	repoInfo := &types.RepoInfo{
+		Exists:      true,
+		IsArchived:  repository.Archived,
+		Description: repository.Description,
+	}
+	if len(repository.Links.Self) > 0 {
+		repoInfo.URL = repository.Links.Self[0].Href
+	}
+
+	// Default branch and latest commit are best-effort, like the other providers
+	var branch BitbucketServerBranch
+	if status, err := bp.getJSON(ctx, repoURL+"/branches/default", &branch); err == nil && status == 200 {
+		repoInfo.DefaultBranch = branch.DisplayID
+	}
+
+	// Without an "until" parameter the commits endpoint lists the default branch
+	var commits BitbucketServerCommits
+	if status, err := bp.getJSON(ctx, repoURL+"/commits?limit=1", &commits); err == nil && status == 200 {
+		if len(commits.Values) > 0 && commits.Values[0].CommitterTimestamp > 0 {
+			commitDate := time.UnixMilli(commits.Values[0].CommitterTimestamp)
+			repoInfo.LastCommitAt = &commitDate
+			repoInfo.UpdatedAt = commitDate
+		}
+	}
+
+	return repoInfo, nil
+}
+
+// getJSON performs an authenticated GET and decodes the body into v when the status is 200
+func (bp *BitbucketServerProvider) getJSON(ctx context.Context, url string, v any) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if bp.token != "" {
+		req.Header.Set("Authorization", "Bearer "+bp.token)
+	}
+
+	resp, err := bp.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return resp.StatusCode, nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return resp.StatusCode, err
+	}
+
+	return resp.StatusCode, nil
+}
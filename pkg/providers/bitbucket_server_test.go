@@ -0,0 +1,111 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newBitbucketServerTestServer(t *testing.T, token string, handler http.HandlerFunc) *BitbucketServerProvider {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	bp, err := NewBitbucketServerProvider(server.URL+"/", token)
+	if err != nil {
+		t.Fatalf("NewBitbucketServerProvider() error: %v", err)
+	}
+	bp.httpClient = server.Client()
+	return bp
+}
+
+func TestNewBitbucketServerProvider(t *testing.T) {
+	bp, err := NewBitbucketServerProvider("https://bitbucket.corp.example/", "")
+	if err != nil {
+		t.Fatalf("NewBitbucketServerProvider() error: %v", err)
+	}
+	if !bp.SupportsHost("bitbucket.corp.example") {
+		t.Error("provider should support its configured host")
+	}
+	if bp.SupportsHost("bitbucket.org") {
+		t.Error("provider should not support bitbucket.org")
+	}
+	if bp.GetName() != "Bitbucket Server" {
+		t.Errorf("GetName() = %q, want %q", bp.GetName(), "Bitbucket Server")
+	}
+
+	for _, invalid := range []string{"bitbucket.corp.example", "://bad", ""} {
+		if _, err := NewBitbucketServerProvider(invalid, ""); err == nil {
+			t.Errorf("NewBitbucketServerProvider(%q) should return error", invalid)
+		}
+	}
+}
+
+func TestBitbucketServerProvider_GetRepositoryInfo_Success(t *testing.T) {
+	lastCommit := time.Now().Add(-2 * 24 * time.Hour).Truncate(time.Millisecond)
+
+	bp := newBitbucketServerTestServer(t, "secret", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/rest/api/1.0/projects/PROJ/repos/lib":
+			fmt.Fprint(w, `{"slug":"lib","name":"lib","description":"Internal lib","archived":true,
+				"project":{"key":"PROJ"},"links":{"self":[{"href":"https://bitbucket.corp.example/projects/PROJ/repos/lib/browse"}]}}`)
+		case "/rest/api/1.0/projects/PROJ/repos/lib/branches/default":
+			fmt.Fprint(w, `{"id":"refs/heads/main","displayId":"main"}`)
+		case "/rest/api/1.0/projects/PROJ/repos/lib/commits":
+			fmt.Fprintf(w, `{"values":[{"id":"abc123","committerTimestamp":%d}]}`, lastCommit.UnixMilli())
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	info, err := bp.GetRepositoryInfo(context.Background(), "PROJ", "lib.git")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if !info.Exists {
+		t.Fatal("expected repository to exist")
+	}
+	if !info.IsArchived {
+		t.Error("expected IsArchived to be true")
+	}
+	if info.DefaultBranch != "main" {
+		t.Errorf("DefaultBranch = %q, want %q", info.DefaultBranch, "main")
+	}
+	if info.URL != "https://bitbucket.corp.example/projects/PROJ/repos/lib/browse" {
+		t.Errorf("URL = %q", info.URL)
+	}
+	if info.LastCommitAt == nil || !info.LastCommitAt.Equal(lastCommit) {
+		t.Errorf("LastCommitAt = %v, want %v", info.LastCommitAt, lastCommit)
+	}
+}
+
+func TestBitbucketServerProvider_GetRepositoryInfo_NotFound(t *testing.T) {
+	bp := newBitbucketServerTestServer(t, "", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	info, err := bp.GetRepositoryInfo(context.Background(), "PROJ", "gone")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if info.Exists {
+		t.Error("expected Exists to be false for 404")
+	}
+}
+
+func TestBitbucketServerProvider_GetRepositoryInfo_Unauthorized(t *testing.T) {
+	bp := newBitbucketServerTestServer(t, "wrong", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	if _, err := bp.GetRepositoryInfo(context.Background(), "PROJ", "lib"); err == nil {
+		t.Error("expected error for 401 response")
+	}
+}
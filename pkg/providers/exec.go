@@ -0,0 +1,95 @@
+package providers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
+)
+
+// ExecProvider delegates repository lookups to an external command, so internal
+// forges can be supported without changes to this tool. The command receives an
+// ExecRequest as JSON on stdin and must print a types.RepoInfo JSON document on stdout.
+// A non-zero exit status is reported as an error, with stderr as the message.
+type ExecProvider struct {
+	host    string
+	command string
+	args    []string
+	timeout time.Duration
+}
+
+// ExecRequest is the JSON document written to an exec provider's stdin
+type ExecRequest struct {
+	Host  string `json:"host"`
+	Owner string `json:"owner"`
+	Repo  string `json:"repo"`
+}
+
+// NewExecProvider creates a provider that runs command (with args) for repositories on host
+func NewExecProvider(host string, command []string) (*ExecProvider, error) {
+	if host == "" {
+		return nil, errors.New("exec provider host is required")
+	}
+	if len(command) == 0 || command[0] == "" {
+		return nil, fmt.Errorf("exec provider for %s needs a command", host)
+	}
+
+	return &ExecProvider{
+		host:    host,
+		command: command[0],
+		args:    command[1:],
+		timeout: 30 * time.Second,
+	}, nil
+}
+
+// GetName returns the provider name
+func (ep *ExecProvider) GetName() string {
+	return ep.host
+}
+
+// SupportsHost checks if this provider supports the given host
+func (ep *ExecProvider) SupportsHost(host string) bool {
+	return host == ep.host
+}
+
+// GetRepositoryInfo runs the command for a repository on the provider's host
+func (ep *ExecProvider) GetRepositoryInfo(ctx context.Context, owner, repo string) (*types.RepoInfo, error) {
+	return ep.GetRepositoryInfoForHost(ctx, ep.host, owner, repo)
+}
+
+// GetRepositoryInfoForHost runs the command and decodes the repository information it prints
+func (ep *ExecProvider) GetRepositoryInfoForHost(ctx context.Context, host, owner, repo string) (*types.RepoInfo, error) {
+	request, err := json.Marshal(ExecRequest{Host: host, Owner: owner, Repo: repo})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ep.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, ep.command, ep.args...) //nolint:gosec // G204: command is configured by the user
+	cmd.Stdin = bytes.NewReader(request)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err = cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("exec provider %s failed: %w: %s", ep.command, err, msg)
+		}
+		return nil, fmt.Errorf("exec provider %s failed: %w", ep.command, err)
+	}
+
+	var repoInfo types.RepoInfo
+	if err = json.Unmarshal(stdout.Bytes(), &repoInfo); err != nil {
+		return nil, fmt.Errorf("exec provider %s returned invalid repository info: %w", ep.command, err)
+	}
+
+	return &repoInfo, nil
+}
@@ -0,0 +1,101 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestExecProviderHelper is not a real test: it is the external command run by the
+// exec provider tests, selected with GO_WANT_EXEC_PROVIDER_HELPER
+func TestExecProviderHelper(_ *testing.T) {
+	mode := os.Getenv("GO_WANT_EXEC_PROVIDER_HELPER")
+	if mode == "" {
+		return
+	}
+
+	var req ExecRequest
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		fmt.Fprintln(os.Stderr, "bad request:", err)
+		os.Exit(2)
+	}
+
+	switch mode {
+	case "ok":
+		fmt.Printf(`{"Exists":true,"IsArchived":%t,"URL":"https://%s/%s/%s","LastCommitAt":"2024-05-06T07:08:09Z"}`,
+			req.Repo == "archived", req.Host, req.Owner, req.Repo)
+	case "fail":
+		fmt.Fprintln(os.Stderr, "forge unavailable")
+		os.Exit(1)
+	case "garbage":
+		fmt.Print("not json")
+	}
+	os.Exit(0)
+}
+
+// newHelperExecProvider returns an exec provider that re-runs this test binary in the given helper mode
+func newHelperExecProvider(t *testing.T, mode string) *ExecProvider {
+	t.Helper()
+	t.Setenv("GO_WANT_EXEC_PROVIDER_HELPER", mode)
+
+	ep, err := NewExecProvider("forge.corp.example", []string{os.Args[0], "-test.run=^TestExecProviderHelper$"})
+	if err != nil {
+		t.Fatalf("NewExecProvider() error: %v", err)
+	}
+	return ep
+}
+
+func TestNewExecProvider(t *testing.T) {
+	if _, err := NewExecProvider("", []string{"forge-info"}); err == nil {
+		t.Error("expected error for missing host")
+	}
+	if _, err := NewExecProvider("forge.corp.example", nil); err == nil {
+		t.Error("expected error for missing command")
+	}
+
+	ep, err := NewExecProvider("forge.corp.example", []string{"forge-info", "--json"})
+	if err != nil {
+		t.Fatalf("NewExecProvider() error: %v", err)
+	}
+	if !ep.SupportsHost("forge.corp.example") || ep.SupportsHost("gitlab.com") {
+		t.Error("exec provider should only support its configured host")
+	}
+	if ep.GetName() != "forge.corp.example" {
+		t.Errorf("GetName() = %q, want forge.corp.example", ep.GetName())
+	}
+}
+
+func TestExecProvider_GetRepositoryInfo(t *testing.T) {
+	ep := newHelperExecProvider(t, "ok")
+
+	info, err := ep.GetRepositoryInfo(context.Background(), "team", "archived")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if !info.Exists || !info.IsArchived {
+		t.Errorf("info = %+v, want existing archived repository", info)
+	}
+	if info.URL != "https://forge.corp.example/team/archived" {
+		t.Errorf("URL = %q, request was not passed on stdin", info.URL)
+	}
+	if info.LastCommitAt == nil || !info.LastCommitAt.Equal(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)) {
+		t.Errorf("LastCommitAt = %v, want 2024-05-06T07:08:09Z", info.LastCommitAt)
+	}
+}
+
+func TestExecProvider_GetRepositoryInfo_Errors(t *testing.T) {
+	ep := newHelperExecProvider(t, "fail")
+	_, err := ep.GetRepositoryInfo(context.Background(), "team", "repo")
+	if err == nil || !strings.Contains(err.Error(), "forge unavailable") {
+		t.Errorf("error = %v, want command stderr in message", err)
+	}
+
+	ep = newHelperExecProvider(t, "garbage")
+	if _, err := ep.GetRepositoryInfo(context.Background(), "team", "repo"); err == nil {
+		t.Error("expected error for invalid JSON output")
+	}
+}
@@ -0,0 +1,178 @@
+package providers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
+)
+
+// defaultGitilesHost is the host a GitilesProvider queries until it is bound to another
+const defaultGitilesHost = "go.googlesource.com"
+
+// gitilesXSSIPrefix is prepended by Gitiles to every JSON response
+var gitilesXSSIPrefix = []byte(")]}'")
+
+// GitilesProvider handles repositories served by Gitiles, such as
+// go.googlesource.com and the other *.googlesource.com hosts.
+// Gitiles serves many hosts from a single implementation, so the provider
+// implements HostProvider, and GetProvider binds it to the host it was looked up for.
+type GitilesProvider struct {
+	httpClient *http.Client
+	scheme     string
+	host       string
+}
+
+// GitilesProject represents the response of /<repo>/?format=JSON
+type GitilesProject struct {
+	Name        string `json:"name"`
+	CloneURL    string `json:"clone_url"`
+	Description string `json:"description"`
+}
+
+// GitilesLog represents the response of /<repo>/+log/<ref>?format=JSON
+type GitilesLog struct {
+	Log []struct {
+		Commit    string `json:"commit"`
+		Committer struct {
+			Name string `json:"name"`
+			Time string `json:"time"`
+		} `json:"committer"`
+	} `json:"log"`
+}
+
+// NewGitilesProvider creates a new Gitiles provider
+func NewGitilesProvider() *GitilesProvider {
+	return &GitilesProvider{
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		scheme: "https",
+		host:   defaultGitilesHost,
+	}
+}
+
+// ForHost returns a copy of the provider whose GetRepositoryInfo queries host
+func (gp *GitilesProvider) ForHost(host string) Provider {
+	bound := *gp
+	bound.host = host
+	return &bound
+}
+
+// GetName returns the provider name
+func (gp *GitilesProvider) GetName() string {
+	return "Gitiles"
+}
+
+// SupportsHost checks if this provider supports the given host
+func (gp *GitilesProvider) SupportsHost(host string) bool {
+	return strings.HasSuffix(host, ".googlesource.com")
+}
+
+// GetRepositoryInfo fetches repository information from the provider's host,
+// go.googlesource.com unless it was bound to another with ForHost
+func (gp *GitilesProvider) GetRepositoryInfo(ctx context.Context, owner, repo string) (*types.RepoInfo, error) {
+	host := gp.host
+	if host == "" {
+		host = defaultGitilesHost
+	}
+	return gp.GetRepositoryInfoForHost(ctx, host, owner, repo)
+}
+
+// GetRepositoryInfoForHost fetches repository information from the given Gitiles host.
+// Gitiles repositories may be nested (e.g. chromium/src), so owner may be empty or
+// contain slashes; it is joined with repo to form the repository path.
+func (gp *GitilesProvider) GetRepositoryInfoForHost(ctx context.Context, host, owner, repo string) (*types.RepoInfo, error) {
+	if repo == "" {
+		return nil, fmt.Errorf("repo name must be provided")
+	}
+
+	repoPath := repo
+	if owner != "" {
+		repoPath = owner + "/" + repo
+	}
+	repoURL := fmt.Sprintf("%s://%s/%s", gp.scheme, host, repoPath)
+
+	var project GitilesProject
+	status, err := gp.getJSON(ctx, repoURL+"/?format=JSON", &project)
+	if err != nil {
+		return nil, err
+	}
+	if status == 404 {
+		return &types.RepoInfo{Exists: false}, nil
+	}
+	if status != 200 {
+		return nil, fmt.Errorf("gitiles returned status %d", status)
+	}
+
+	repoInfo := &types.RepoInfo{
+		Exists:        true,
+		IsArchived:    false, // Gitiles does not expose an archived status
+		Description:   project.Description,
+		DefaultBranch: "HEAD",
+		URL:           repoURL,
+	}
+
+	// Get the latest commit on the default branch. An empty repository has no
+	// HEAD to log, so a 404 here is not an error.
+	var log GitilesLog
+	status, err = gp.getJSON(ctx, repoURL+"/+log/HEAD?format=JSON&n=1", &log)
+	if err != nil {
+		return nil, err
+	}
+	if status == 200 && len(log.Log) > 0 {
+		if commitDate, ok := parseGitilesTime(log.Log[0].Committer.Time); ok {
+			repoInfo.LastCommitAt = &commitDate
+			repoInfo.UpdatedAt = commitDate
+		}
+	}
+
+	return repoInfo, nil
+}
+
+// getJSON fetches a Gitiles JSON endpoint and decodes it into v when the status is 200.
+// The HTTP status code is returned so callers can distinguish missing resources.
+func (gp *GitilesProvider) getJSON(ctx context.Context, url string, v any) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := gp.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return resp.StatusCode, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+
+	body = bytes.TrimPrefix(bytes.TrimSpace(body), gitilesXSSIPrefix)
+	if err := json.Unmarshal(body, v); err != nil {
+		return resp.StatusCode, fmt.Errorf("failed to parse Gitiles response: %w", err)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// parseGitilesTime parses a Gitiles commit time such as "Fri Oct 11 17:47:24 2024 +0000"
+func parseGitilesTime(value string) (time.Time, bool) {
+	for _, layout := range []string{"Mon Jan 02 15:04:05 2006 -0700", "Mon Jan 02 15:04:05 2006", time.ANSIC} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
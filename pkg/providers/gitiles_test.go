@@ -0,0 +1,178 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// newGitilesTestServer returns a provider and the host:port of a local Gitiles stand-in
+func newGitilesTestServer(t *testing.T, handler http.HandlerFunc) (*GitilesProvider, string) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("failed to parse server URL: %v", err)
+	}
+
+	return &GitilesProvider{
+		httpClient: server.Client(),
+		scheme:     "http",
+	}, u.Host
+}
+
+func TestGitilesProvider_SupportsHost(t *testing.T) {
+	gp := NewGitilesProvider()
+
+	for _, host := range []string{"go.googlesource.com", "chromium.googlesource.com"} {
+		if !gp.SupportsHost(host) {
+			t.Errorf("Gitiles provider should support %s", host)
+		}
+	}
+	for _, host := range []string{"googlesource.com", "github.com", "golang.org"} {
+		if gp.SupportsHost(host) {
+			t.Errorf("Gitiles provider should not support %s", host)
+		}
+	}
+}
+
+func TestGitilesProvider_GetName(t *testing.T) {
+	gp := NewGitilesProvider()
+	if gp.GetName() != "Gitiles" {
+		t.Errorf("GetName() = %q, want %q", gp.GetName(), "Gitiles")
+	}
+}
+
+func TestGitilesProvider_GetRepositoryInfoForHost_Success(t *testing.T) {
+	lastCommit := time.Now().Add(-3 * 24 * time.Hour).Truncate(time.Second).UTC()
+
+	gp, host := newGitilesTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("format") != "JSON" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/infra/luci/":
+			fmt.Fprint(w, ")]}'\n"+`{"name":"infra/luci","clone_url":"https://chromium.googlesource.com/infra/luci","description":"LUCI"}`)
+		case "/infra/luci/+log/HEAD":
+			fmt.Fprintf(w, ")]}'\n"+`{"log":[{"commit":"abc123","committer":{"name":"Dev","time":%q}}]}`,
+				lastCommit.Format("Mon Jan 02 15:04:05 2006 -0700"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	info, err := gp.GetRepositoryInfoForHost(context.Background(), host, "infra", "luci")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfoForHost() error: %v", err)
+	}
+	if !info.Exists {
+		t.Fatal("expected repository to exist")
+	}
+	if info.Description != "LUCI" {
+		t.Errorf("Description = %q, want %q", info.Description, "LUCI")
+	}
+	if info.LastCommitAt == nil || !info.LastCommitAt.Equal(lastCommit) {
+		t.Errorf("LastCommitAt = %v, want %v", info.LastCommitAt, lastCommit)
+	}
+	if !info.IsRepositoryActive(30 * 24 * time.Hour) {
+		t.Error("expected repository to be active")
+	}
+}
+
+func TestGitilesProvider_GetRepositoryInfoForHost_Deleted(t *testing.T) {
+	gp, host := newGitilesTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	info, err := gp.GetRepositoryInfoForHost(context.Background(), host, "", "gone")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfoForHost() error: %v", err)
+	}
+	if info.Exists {
+		t.Error("expected Exists to be false for 404")
+	}
+}
+
+func TestGitilesProvider_GetRepositoryInfoForHost_EmptyRepository(t *testing.T) {
+	gp, host := newGitilesTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/empty/" {
+			fmt.Fprint(w, ")]}'\n"+`{"name":"empty"}`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	info, err := gp.GetRepositoryInfoForHost(context.Background(), host, "", "empty")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfoForHost() error: %v", err)
+	}
+	if !info.Exists {
+		t.Error("expected empty repository to exist")
+	}
+	if info.LastCommitAt != nil {
+		t.Errorf("LastCommitAt = %v, want nil", info.LastCommitAt)
+	}
+}
+
+func TestParseGitilesTime(t *testing.T) {
+	tests := []struct {
+		value string
+		ok    bool
+	}{
+		{"Fri Oct 11 17:47:24 2024 +0000", true},
+		{"Fri Oct 11 17:47:24 2024", true},
+		{"Fri Oct  4 17:47:24 2024", true},
+		{"2024-10-11T17:47:24Z", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			_, ok := parseGitilesTime(tt.value)
+			if ok != tt.ok {
+				t.Errorf("parseGitilesTime(%q) ok = %v, want %v", tt.value, ok, tt.ok)
+			}
+		})
+	}
+}
+
+// roundTripFunc adapts a function to http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestGitilesProvider_BoundToLookedUpHost(t *testing.T) {
+	var queried []string
+	gp := &GitilesProvider{
+		httpClient: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			queried = append(queried, r.URL.Host)
+			return &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody, Request: r}, nil
+		})},
+		scheme: "https",
+		host:   defaultGitilesHost,
+	}
+	mp := &MultiProvider{providers: []Provider{gp}}
+
+	p := mp.GetProvider("chromium.googlesource.com")
+	if p == nil {
+		t.Fatal("GetProvider() = nil, want Gitiles")
+	}
+	info, err := p.GetRepositoryInfo(context.Background(), "infra", "luci")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if info.Exists {
+		t.Error("expected the stand-in 404 to report a missing repository")
+	}
+	if len(queried) == 0 || queried[0] != "chromium.googlesource.com" {
+		t.Errorf("queried hosts = %v, want chromium.googlesource.com", queried)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -18,29 +19,61 @@ type Provider interface {
 	SupportsHost(host string) bool
 }
 
+// HostProvider is implemented by providers that serve many hosts (such as Gitiles)
+// and need to know which host a repository lives on
+type HostProvider interface {
+	Provider
+	GetRepositoryInfoForHost(ctx context.Context, host, owner, repo string) (*types.RepoInfo, error)
+}
+
+// hostBinder is implemented by HostProviders that can be bound to one of their hosts, so
+// that GetRepositoryInfo queries it
+type hostBinder interface {
+	ForHost(host string) Provider
+}
+
 // MultiProvider manages multiple hosting providers
 type MultiProvider struct {
 	providers []Provider
 }
 
-// NewMultiProvider creates a new multi-provider instance
+// NewMultiProvider creates a new multi-provider instance with every registered provider
 func NewMultiProvider() *MultiProvider {
 	return &MultiProvider{
-		providers: []Provider{
-			NewGitLabProvider(),
-			NewBitbucketProvider(),
-		},
+		providers: DefaultProviders(),
 	}
 }
 
-// GetRepositoryInfo attempts to get repository info from the appropriate provider
-func (mp *MultiProvider) GetRepositoryInfo(ctx context.Context, host, owner, repo string) (*types.RepoInfo, error) {
+// AddProvider registers an additional provider. Providers added later take
+// precedence, so configured instances can override the built-in defaults.
+func (mp *MultiProvider) AddProvider(provider Provider) {
+	mp.providers = append([]Provider{provider}, mp.providers...)
+}
+
+// GetProvider returns the provider that supports the given host, or nil. A provider serving
+// many hosts is returned bound to host.
+func (mp *MultiProvider) GetProvider(host string) Provider {
 	for _, provider := range mp.providers {
 		if provider.SupportsHost(host) {
-			return provider.GetRepositoryInfo(ctx, owner, repo)
+			if binder, ok := provider.(hostBinder); ok {
+				return binder.ForHost(host)
+			}
+			return provider
 		}
 	}
 
+	return nil
+}
+
+// GetRepositoryInfo attempts to get repository info from the appropriate provider
+func (mp *MultiProvider) GetRepositoryInfo(ctx context.Context, host, owner, repo string) (*types.RepoInfo, error) {
+	if provider := mp.GetProvider(host); provider != nil {
+		if hp, ok := provider.(HostProvider); ok {
+			return hp.GetRepositoryInfoForHost(ctx, host, owner, repo)
+		}
+		return provider.GetRepositoryInfo(ctx, owner, repo)
+	}
+
 	return nil, fmt.Errorf("no provider supports host: %s", host)
 }
 
@@ -51,14 +84,45 @@ type GitLabProvider struct {
 
 // GitLabProject represents a GitLab project response
 type GitLabProject struct {
-	CreatedAt      time.Time `json:"created_at"`
-	LastActivityAt time.Time `json:"last_activity_at"`
-	Name           string    `json:"name"`
-	Description    string    `json:"description"`
-	WebURL         string    `json:"web_url"`
-	DefaultBranch  string    `json:"default_branch"`
-	ID             int       `json:"id"`
-	Archived       bool      `json:"archived"`
+	CreatedAt         time.Time `json:"created_at"`
+	LastActivityAt    time.Time `json:"last_activity_at"`
+	Name              string    `json:"name"`
+	PathWithNamespace string    `json:"path_with_namespace"`
+	Description       string    `json:"description"`
+	WebURL            string    `json:"web_url"`
+	DefaultBranch     string    `json:"default_branch"`
+	Topics            []string  `json:"topics"`
+	// License is only returned when requested with license=true; null means none was detected
+	License *struct {
+		Key string `json:"key"`
+	} `json:"license"`
+	ID                int       `json:"id"`
+	Archived          bool      `json:"archived"`
+}
+
+// gitLabLicenses maps GitLab's lowercase license keys to SPDX identifiers where the two differ
+// by more than case
+var gitLabLicenses = map[string]string{
+	"mit":          "MIT",
+	"isc":          "ISC",
+	"apache-2.0":   "Apache-2.0",
+	"bsd-2-clause": "BSD-2-Clause",
+	"bsd-3-clause": "BSD-3-Clause",
+	"mpl-2.0":      "MPL-2.0",
+	"unlicense":    "Unlicense",
+	"0bsd":         "0BSD",
+}
+
+// gitLabSPDX converts a GitLab license key to an SPDX identifier, e.g. "apache-2.0" to
+// "Apache-2.0". Keys of other licenses, such as "gpl-3.0", are SPDX identifiers in upper case.
+func gitLabSPDX(key string) string {
+	if id, ok := gitLabLicenses[key]; ok {
+		return id
+	}
+	if key == "" || key == "other" {
+		return "NOASSERTION"
+	}
+	return strings.ToUpper(key)
 }
 
 // NewGitLabProvider creates a new GitLab provider
@@ -84,7 +148,7 @@ func (gp *GitLabProvider) SupportsHost(host string) bool {
 func (gp *GitLabProvider) GetRepositoryInfo(ctx context.Context, owner, repo string) (*types.RepoInfo, error) {
 	// GitLab API endpoint for projects
 	projectPath := fmt.Sprintf("%s/%s", owner, repo)
-	url := fmt.Sprintf("https://gitlab.com/api/v4/projects/%s", strings.ReplaceAll(projectPath, "/", "%2F"))
+	url := fmt.Sprintf("https://gitlab.com/api/v4/projects/%s?license=true", strings.ReplaceAll(projectPath, "/", "%2F"))
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
@@ -114,13 +178,20 @@ func (gp *GitLabProvider) GetRepositoryInfo(ctx context.Context, owner, repo str
 	repoInfo := &types.RepoInfo{
 		Exists:        true,
 		IsArchived:    project.Archived,
+		FullName:      project.PathWithNamespace,
 		Description:   project.Description,
 		DefaultBranch: project.DefaultBranch,
+		Topics:        project.Topics,
+		License:       types.NoLicense,
 		CreatedAt:     project.CreatedAt,
 		UpdatedAt:     project.LastActivityAt,
 		URL:           project.WebURL,
 	}
 
+	if project.License != nil {
+		repoInfo.License = gitLabSPDX(project.License.Key)
+	}
+
 	// Use LastActivityAt as commit time if available
 	if !project.LastActivityAt.IsZero() {
 		repoInfo.LastCommitAt = &project.LastActivityAt
@@ -129,9 +200,10 @@ func (gp *GitLabProvider) GetRepositoryInfo(ctx context.Context, owner, repo str
 	return repoInfo, nil
 }
 
-// BitbucketProvider handles Bitbucket repositories
+// BitbucketProvider handles Bitbucket Cloud repositories
 type BitbucketProvider struct {
 	httpClient *http.Client
+	baseURL    string
 }
 
 // BitbucketRepository represents a Bitbucket repository response
@@ -151,6 +223,16 @@ type BitbucketRepository struct {
 		Name string `json:"name"`
 	} `json:"mainbranch"`
 	IsPrivate bool `json:"is_private"`
+	// Archived is only present on repositories where Bitbucket exposes archiving
+	Archived bool `json:"archived"`
+}
+
+// BitbucketCommits represents a page of the Bitbucket commits endpoint
+type BitbucketCommits struct {
+	Values []struct {
+		Hash string    `json:"hash"`
+		Date time.Time `json:"date"`
+	} `json:"values"`
 }
 
 // NewBitbucketProvider creates a new Bitbucket provider
@@ -159,6 +241,7 @@ func NewBitbucketProvider() *BitbucketProvider {
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
+		baseURL: "https://api.bitbucket.org/2.0",
 	}
 }
 
@@ -175,9 +258,9 @@ func (bp *BitbucketProvider) SupportsHost(host string) bool {
 // GetRepositoryInfo fetches repository information from Bitbucket
 func (bp *BitbucketProvider) GetRepositoryInfo(ctx context.Context, owner, repo string) (*types.RepoInfo, error) {
 	// Bitbucket API endpoint for repositories
-	url := fmt.Sprintf("https://api.bitbucket.org/2.0/repositories/%s/%s", owner, repo)
+	repoURL := fmt.Sprintf("%s/repositories/%s/%s", bp.baseURL, url.PathEscape(owner), url.PathEscape(repo))
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", repoURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +287,8 @@ func (bp *BitbucketProvider) GetRepositoryInfo(ctx context.Context, owner, repo
 	// Convert to common RepoInfo format
 	repoInfo := &types.RepoInfo{
 		Exists:        true,
-		IsArchived:    false, // Bitbucket doesn't have archived status in this endpoint
+		IsArchived:    repository.Archived,
+		FullName:      repository.FullName,
 		Description:   repository.Description,
 		DefaultBranch: repository.MainBranch.Name,
 		CreatedAt:     repository.CreatedOn,
@@ -212,26 +296,53 @@ func (bp *BitbucketProvider) GetRepositoryInfo(ctx context.Context, owner, repo
 		URL:           repository.Links.HTML.Href,
 	}
 
-	// Use UpdatedOn as commit time approximation
-	if !repository.UpdatedOn.IsZero() {
-		repoInfo.LastCommitAt = &repository.UpdatedOn
+	// Get the latest commit on the main branch. Don't fail the entire
+	// request for commit info, just skip it.
+	// Renamed repositories redirect to their new location; follow it for commits too
+	if commitDate, err := bp.getLatestCommitDate(ctx, resp.Request.URL.String(), repository.MainBranch.Name); err == nil && commitDate != nil {
+		repoInfo.LastCommitAt = commitDate
 	}
 
 	return repoInfo, nil
 }
 
-// GetProviderForHost returns the appropriate provider for a given host
-func GetProviderForHost(host string) Provider {
-	providers := []Provider{
-		NewGitLabProvider(),
-		NewBitbucketProvider(),
+// getLatestCommitDate returns the date of the newest commit on branch, or nil for an empty repository
+func (bp *BitbucketProvider) getLatestCommitDate(ctx context.Context, repoURL, branch string) (*time.Time, error) {
+	commitsURL := repoURL + "/commits"
+	if branch != "" {
+		// Branch names such as release/1.x are a single path segment
+		commitsURL += "/" + url.PathEscape(branch)
 	}
+	commitsURL += "?pagelen=1"
 
-	for _, provider := range providers {
-		if provider.SupportsHost(host) {
-			return provider
-		}
+	req, err := http.NewRequestWithContext(ctx, "GET", commitsURL, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	resp, err := bp.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("bitbucket commits API returned status %d", resp.StatusCode)
+	}
+
+	var commits BitbucketCommits
+	if err := json.NewDecoder(resp.Body).Decode(&commits); err != nil {
+		return nil, err
+	}
+
+	if len(commits.Values) == 0 || commits.Values[0].Date.IsZero() {
+		return nil, nil
+	}
+
+	return &commits.Values[0].Date, nil
+}
+
+// GetProviderForHost returns the appropriate registered provider for a given host
+func GetProviderForHost(host string) Provider {
+	return NewMultiProvider().GetProvider(host)
 }
@@ -113,6 +113,130 @@ func TestBitbucketProvider_GetName(t *testing.T) {
 	}
 }
 
+func TestBitbucketProvider_GetRepositoryInfo_SlashedBranch(t *testing.T) {
+	lastCommit := time.Now().Add(-10 * 24 * time.Hour).Truncate(time.Second).UTC()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/repositories/team/lib":
+			repository := BitbucketRepository{Name: "lib", FullName: "team/lib"}
+			repository.MainBranch.Name = "release/1.x"
+			if err := json.NewEncoder(w).Encode(repository); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		case "/repositories/team/lib/commits/release%2F1.x":
+			if err := json.NewEncoder(w).Encode(map[string]any{
+				"values": []map[string]any{{"hash": "abc123", "date": lastCommit}},
+			}); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	bp := &BitbucketProvider{httpClient: server.Client(), baseURL: server.URL}
+	info, err := bp.GetRepositoryInfo(context.Background(), "team", "lib")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if info.LastCommitAt == nil || !info.LastCommitAt.Equal(lastCommit) {
+		t.Errorf("LastCommitAt = %v, want %v from the release/1.x branch", info.LastCommitAt, lastCommit)
+	}
+}
+
+func TestBitbucketProvider_GetRepositoryInfo(t *testing.T) {
+	lastCommit := time.Now().Add(-400 * 24 * time.Hour).Truncate(time.Second).UTC()
+	updatedOn := time.Now().Add(-1 * 24 * time.Hour).Truncate(time.Second).UTC()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repositories/team/lib":
+			repository := BitbucketRepository{
+				Name:        "lib",
+				FullName:    "team/lib",
+				Description: "A Bitbucket library",
+				UpdatedOn:   updatedOn,
+			}
+			repository.MainBranch.Name = "develop"
+			if err := json.NewEncoder(w).Encode(repository); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		case "/repositories/team/old-lib":
+			http.Redirect(w, r, "/repositories/team/lib", http.StatusMovedPermanently)
+		case "/repositories/team/lib/commits/develop":
+			if r.URL.Query().Get("pagelen") != "1" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if err := json.NewEncoder(w).Encode(map[string]any{
+				"values": []map[string]any{{"hash": "abc123", "date": lastCommit}},
+			}); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	bp := &BitbucketProvider{httpClient: server.Client(), baseURL: server.URL}
+	ctx := context.Background()
+
+	info, err := bp.GetRepositoryInfo(ctx, "team", "lib")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if !info.Exists {
+		t.Fatal("expected repository to exist")
+	}
+	if info.DefaultBranch != "develop" {
+		t.Errorf("DefaultBranch = %q, want %q", info.DefaultBranch, "develop")
+	}
+	// The last commit should come from the commits endpoint, not updated_on
+	if info.LastCommitAt == nil || !info.LastCommitAt.Equal(lastCommit) {
+		t.Errorf("LastCommitAt = %v, want %v", info.LastCommitAt, lastCommit)
+	}
+
+	// A renamed repository reports its new full name, and its commits are still found
+	info, err = bp.GetRepositoryInfo(ctx, "team", "old-lib")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if info.FullName != "team/lib" {
+		t.Errorf("FullName = %q, want team/lib", info.FullName)
+	}
+	if info.LastCommitAt == nil {
+		t.Error("LastCommitAt should be fetched from the new location")
+	}
+
+	info, err = bp.GetRepositoryInfo(ctx, "team", "missing")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if info.Exists {
+		t.Error("expected Exists to be false for 404")
+	}
+}
+
+func TestMultiProvider_AddProvider(t *testing.T) {
+	mp := NewMultiProvider()
+	bp, err := NewBitbucketServerProvider("https://bitbucket.corp.example", "")
+	if err != nil {
+		t.Fatalf("NewBitbucketServerProvider() error: %v", err)
+	}
+	mp.AddProvider(bp)
+
+	p := mp.GetProvider("bitbucket.corp.example")
+	if p == nil || p.GetName() != "Bitbucket Server" {
+		t.Errorf("GetProvider() = %v, want Bitbucket Server", p)
+	}
+	if p := mp.GetProvider("gitlab.com"); p == nil || p.GetName() != "GitLab" {
+		t.Errorf("built-in providers should still be available, got %v", p)
+	}
+}
+
 func TestMultiProvider_GetRepositoryInfo_UnsupportedHost(t *testing.T) {
 	mp := NewMultiProvider()
 	ctx := context.Background()
@@ -131,6 +255,8 @@ func TestGetProviderForHost(t *testing.T) {
 	}{
 		{"gitlab.com", "GitLab", false},
 		{"bitbucket.org", "Bitbucket", false},
+		{"git.sr.ht", "SourceHut", false},
+		{"go.googlesource.com", "Gitiles", false},
 		{"github.com", "", true},
 		{"unknown.com", "", true},
 	}
@@ -159,7 +285,27 @@ func TestNewMultiProvider(t *testing.T) {
 	if mp == nil {
 		t.Fatal("NewMultiProvider() returned nil")
 	}
-	if len(mp.providers) != 2 {
-		t.Errorf("providers count = %d, want 2", len(mp.providers))
+	if len(mp.providers) != 4 {
+		t.Errorf("providers count = %d, want 4", len(mp.providers))
+	}
+}
+
+func TestGitLabSPDX(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"mit", "MIT"},
+		{"apache-2.0", "Apache-2.0"},
+		{"bsd-3-clause", "BSD-3-Clause"},
+		{"gpl-3.0", "GPL-3.0"},
+		{"lgpl-2.1", "LGPL-2.1"},
+		{"other", "NOASSERTION"},
+	}
+
+	for _, tt := range tests {
+		if got := gitLabSPDX(tt.key); got != tt.want {
+			t.Errorf("gitLabSPDX(%q) = %q, want %q", tt.key, got, tt.want)
+		}
 	}
 }
@@ -0,0 +1,37 @@
+package providers
+
+import "sync"
+
+// Factory creates a new instance of a provider
+type Factory func() Provider
+
+var (
+	registryMu sync.RWMutex
+	registry   = []Factory{
+		func() Provider { return NewGitLabProvider() },
+		func() Provider { return NewBitbucketProvider() },
+		func() Provider { return NewSourceHutProvider() },
+		func() Provider { return NewGitilesProvider() },
+	}
+)
+
+// Register adds a provider factory to the default set used by NewMultiProvider and
+// GetProviderForHost. Providers registered later take precedence for the hosts they support.
+func Register(factory Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	registry = append(registry, factory)
+}
+
+// DefaultProviders returns a fresh instance of every registered provider, highest precedence first
+func DefaultProviders() []Provider {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	providers := make([]Provider, 0, len(registry))
+	for i := len(registry) - 1; i >= 0; i-- {
+		providers = append(providers, registry[i]())
+	}
+	return providers
+}
@@ -0,0 +1,45 @@
+package providers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
+)
+
+// stubProvider is a minimal Provider for registry tests
+type stubProvider struct {
+	name string
+	host string
+}
+
+func (sp *stubProvider) GetName() string               { return sp.name }
+func (sp *stubProvider) SupportsHost(host string) bool { return host == sp.host }
+func (sp *stubProvider) GetRepositoryInfo(_ context.Context, _, _ string) (*types.RepoInfo, error) {
+	return &types.RepoInfo{Exists: true}, nil
+}
+
+func TestRegister(t *testing.T) {
+	registryMu.Lock()
+	saved := registry
+	registry = append([]Factory(nil), registry...)
+	registryMu.Unlock()
+	t.Cleanup(func() {
+		registryMu.Lock()
+		registry = saved
+		registryMu.Unlock()
+	})
+
+	Register(func() Provider { return &stubProvider{name: "Forge", host: "forge.corp.example"} })
+	Register(func() Provider { return &stubProvider{name: "Custom GitLab", host: "gitlab.com"} })
+
+	if p := GetProviderForHost("forge.corp.example"); p == nil || p.GetName() != "Forge" {
+		t.Errorf("GetProviderForHost(forge.corp.example) = %v, want Forge", p)
+	}
+	if p := NewMultiProvider().GetProvider("gitlab.com"); p == nil || p.GetName() != "Custom GitLab" {
+		t.Errorf("later registrations should take precedence, got %v", p)
+	}
+	if got := len(DefaultProviders()); got != len(saved)+2 {
+		t.Errorf("len(DefaultProviders()) = %d, want %d", got, len(saved)+2)
+	}
+}
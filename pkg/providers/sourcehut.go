@@ -0,0 +1,122 @@
+package providers
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/johnsaigle/go-unmaintained/pkg/types"
+)
+
+// SourceHutProvider handles SourceHut (git.sr.ht) repositories.
+// SourceHut's GraphQL API requires an OAuth token, so this provider reads the
+// public commit log RSS feed instead, which is available without authentication.
+type SourceHutProvider struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// sourceHutFeed represents the RSS feed served at /~owner/repo/log/rss.xml
+type sourceHutFeed struct {
+	Channel struct {
+		Title       string `xml:"title"`
+		Link        string `xml:"link"`
+		Description string `xml:"description"`
+		Items       []struct {
+			Title   string `xml:"title"`
+			Link    string `xml:"link"`
+			PubDate string `xml:"pubDate"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+// NewSourceHutProvider creates a new SourceHut provider
+func NewSourceHutProvider() *SourceHutProvider {
+	return &SourceHutProvider{
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		baseURL: "https://git.sr.ht",
+	}
+}
+
+// GetName returns the provider name
+func (sp *SourceHutProvider) GetName() string {
+	return "SourceHut"
+}
+
+// SupportsHost checks if this provider supports the given host
+func (sp *SourceHutProvider) SupportsHost(host string) bool {
+	return host == "git.sr.ht"
+}
+
+// GetRepositoryInfo fetches repository information from SourceHut
+func (sp *SourceHutProvider) GetRepositoryInfo(ctx context.Context, owner, repo string) (*types.RepoInfo, error) {
+	if owner == "" || repo == "" {
+		return nil, fmt.Errorf("owner and repo name must be provided")
+	}
+
+	// SourceHut owners are addressed as ~user; accept either form
+	if !strings.HasPrefix(owner, "~") {
+		owner = "~" + owner
+	}
+
+	repoURL := fmt.Sprintf("%s/%s/%s", sp.baseURL, owner, repo)
+	url := repoURL + "/log/rss.xml"
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := sp.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 404 {
+		return &types.RepoInfo{Exists: false}, nil
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("SourceHut returned status %d", resp.StatusCode)
+	}
+
+	var feed sourceHutFeed
+	if err := xml.NewDecoder(resp.Body).Decode(&feed); err != nil {
+		return nil, fmt.Errorf("failed to parse SourceHut log feed: %w", err)
+	}
+
+	// Convert to common RepoInfo format
+	repoInfo := &types.RepoInfo{
+		Exists:      true,
+		IsArchived:  false, // SourceHut has no archived status
+		Description: feed.Channel.Description,
+		URL:         repoURL,
+	}
+
+	// The feed lists commits newest first; an empty feed means an empty repository
+	if len(feed.Channel.Items) > 0 {
+		if commitDate, ok := parseRSSDate(feed.Channel.Items[0].PubDate); ok {
+			repoInfo.LastCommitAt = &commitDate
+			repoInfo.UpdatedAt = commitDate
+		}
+	}
+
+	return repoInfo, nil
+}
+
+// parseRSSDate parses an RSS pubDate, accepting both numeric and named zones
+func parseRSSDate(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	for _, layout := range []string{time.RFC1123Z, time.RFC1123} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
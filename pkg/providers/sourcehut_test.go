@@ -0,0 +1,162 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const sourceHutFeedFixture = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+  <channel>
+    <title>~user/repo log</title>
+    <link>https://git.sr.ht/~user/repo</link>
+    <description>A test repository</description>
+    <item>
+      <title>Fix the thing</title>
+      <link>https://git.sr.ht/~user/repo/commit/abc123</link>
+      <pubDate>%s</pubDate>
+    </item>
+    <item>
+      <title>Initial commit</title>
+      <link>https://git.sr.ht/~user/repo/commit/def456</link>
+      <pubDate>Mon, 02 Jan 2006 15:04:05 +0000</pubDate>
+    </item>
+  </channel>
+</rss>`
+
+func newSourceHutTestServer(t *testing.T, handler http.HandlerFunc) *SourceHutProvider {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &SourceHutProvider{
+		httpClient: server.Client(),
+		baseURL:    server.URL,
+	}
+}
+
+func TestSourceHutProvider_SupportsHost(t *testing.T) {
+	sp := NewSourceHutProvider()
+
+	if !sp.SupportsHost("git.sr.ht") {
+		t.Error("SourceHut provider should support git.sr.ht")
+	}
+	if sp.SupportsHost("sr.ht") {
+		t.Error("SourceHut provider should not support sr.ht")
+	}
+	if sp.SupportsHost("github.com") {
+		t.Error("SourceHut provider should not support github.com")
+	}
+}
+
+func TestSourceHutProvider_GetName(t *testing.T) {
+	sp := NewSourceHutProvider()
+	if sp.GetName() != "SourceHut" {
+		t.Errorf("GetName() = %q, want %q", sp.GetName(), "SourceHut")
+	}
+}
+
+func TestSourceHutProvider_GetRepositoryInfo_Success(t *testing.T) {
+	lastCommit := time.Now().Add(-5 * 24 * time.Hour).Truncate(time.Second).UTC()
+
+	sp := newSourceHutTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/~user/repo/log/rss.xml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/rss+xml")
+		fmt.Fprintf(w, sourceHutFeedFixture, lastCommit.Format(time.RFC1123Z))
+	})
+
+	// Owner without the tilde should be normalized to ~user
+	for _, owner := range []string{"~user", "user"} {
+		t.Run(owner, func(t *testing.T) {
+			info, err := sp.GetRepositoryInfo(context.Background(), owner, "repo")
+			if err != nil {
+				t.Fatalf("GetRepositoryInfo() error: %v", err)
+			}
+			if !info.Exists {
+				t.Fatal("expected repository to exist")
+			}
+			if info.Description != "A test repository" {
+				t.Errorf("Description = %q, want %q", info.Description, "A test repository")
+			}
+			if info.LastCommitAt == nil {
+				t.Fatal("expected LastCommitAt to be set")
+			}
+			if !info.LastCommitAt.Equal(lastCommit) {
+				t.Errorf("LastCommitAt = %v, want %v", info.LastCommitAt, lastCommit)
+			}
+			if !info.IsRepositoryActive(30 * 24 * time.Hour) {
+				t.Error("expected repository to be active")
+			}
+		})
+	}
+}
+
+func TestSourceHutProvider_GetRepositoryInfo_NotFound(t *testing.T) {
+	sp := newSourceHutTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	info, err := sp.GetRepositoryInfo(context.Background(), "~user", "gone")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if info.Exists {
+		t.Error("expected Exists to be false for 404")
+	}
+}
+
+func TestSourceHutProvider_GetRepositoryInfo_EmptyRepository(t *testing.T) {
+	sp := newSourceHutTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<rss version="2.0"><channel><title>~user/empty log</title></channel></rss>`)
+	})
+
+	info, err := sp.GetRepositoryInfo(context.Background(), "~user", "empty")
+	if err != nil {
+		t.Fatalf("GetRepositoryInfo() error: %v", err)
+	}
+	if !info.Exists {
+		t.Error("expected empty repository to exist")
+	}
+	if info.LastCommitAt != nil {
+		t.Errorf("LastCommitAt = %v, want nil", info.LastCommitAt)
+	}
+}
+
+func TestSourceHutProvider_GetRepositoryInfo_ServerError(t *testing.T) {
+	sp := newSourceHutTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	if _, err := sp.GetRepositoryInfo(context.Background(), "~user", "repo"); err == nil {
+		t.Error("expected error for 500 response")
+	}
+}
+
+func TestParseRSSDate(t *testing.T) {
+	tests := []struct {
+		value string
+		ok    bool
+	}{
+		{"Mon, 02 Jan 2006 15:04:05 +0000", true},
+		{"Mon, 02 Jan 2006 15:04:05 UTC", true},
+		{"  Mon, 02 Jan 2006 15:04:05 -0700  ", true},
+		{"2006-01-02T15:04:05Z", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			_, ok := parseRSSDate(tt.value)
+			if ok != tt.ok {
+				t.Errorf("parseRSSDate(%q) ok = %v, want %v", tt.value, ok, tt.ok)
+			}
+		})
+	}
+}
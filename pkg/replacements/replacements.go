@@ -0,0 +1,108 @@
+// Package replacements suggests what to move to when a dependency is no longer maintained,
+// from a curated mapping and from the notices maintainers leave behind.
+package replacements
+
+import (
+	_ "embed"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"golang.org/x/mod/module"
+)
+
+//go:embed data/replacements.json
+var replacementData []byte
+
+// Replacement is a curated successor for an unmaintained module
+type Replacement struct {
+	Module      string `json:"module"`
+	Replacement string `json:"replacement"`
+	// Note is a short hint on how to migrate, e.g. which functions take over
+	Note string `json:"note,omitempty"`
+	// Stdlib marks Replacement as a standard library package rather than a module
+	Stdlib bool `json:"stdlib,omitempty"`
+}
+
+// String renders the replacement for display, e.g. "errors (standard library): errors.Join"
+func (r *Replacement) String() string {
+	s := r.Replacement
+	if r.Stdlib {
+		s += " (standard library)"
+	}
+	if r.Note != "" {
+		s += ": " + r.Note
+	}
+	return s
+}
+
+var curated map[string]*Replacement
+
+func init() {
+	// Fail gracefully, as the popular package cache does: no data means no suggestions
+	var entries []Replacement
+	if err := json.Unmarshal(replacementData, &entries); err != nil {
+		return
+	}
+
+	curated = make(map[string]*Replacement, len(entries))
+	for i := range entries {
+		curated[entries[i].Module] = &entries[i]
+	}
+}
+
+// Lookup returns the curated replacement for modulePath. Later major versions of a curated
+// module, such as github.com/gobuffalo/packr/v2, share its entry.
+func Lookup(modulePath string) (*Replacement, bool) {
+	if replacement, ok := curated[modulePath]; ok {
+		return replacement, true
+	}
+	if prefix, pathMajor, ok := module.SplitPathVersion(modulePath); ok && pathMajor != "" {
+		replacement, found := curated[prefix]
+		return replacement, found
+	}
+	return nil, false
+}
+
+// successorPatterns capture the successor named in a deprecation notice or repository
+// description, e.g. "Moved to github.com/new/lib" or "Use example.com/v2 instead"
+var successorPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)\b(?:moved|migrated|relocated|transferred)\s+(?:permanently\s+)?to:?\s+(\S+)`),
+	regexp.MustCompile(`(?i)\b(?:use|switch\s+to|migrate\s+to)\s+(\S+)\s+instead\b`),
+	regexp.MustCompile(`(?i)\b(?:in\s+favou?r\s+of|replaced\s+by|superseded\s+by|succeeded\s+by)\s+(\S+)`),
+	regexp.MustCompile(`(?i)\bnow\s+(?:lives|maintained|developed|hosted)\s+(?:at|in)\s+(\S+)`),
+	regexp.MustCompile(`(?i)\b(?:please\s+)?use\s+(\S+)`),
+}
+
+// ParseSuccessor extracts the module path or repository a notice points to, such as a
+// go.mod deprecation comment or an archived repository's description. Links are reduced
+// to their host and path. It returns "" if no successor is named.
+func ParseSuccessor(notice string) string {
+	for _, pattern := range successorPatterns {
+		for _, match := range pattern.FindAllStringSubmatch(notice, -1) {
+			if successor := cleanSuccessor(match[1]); successor != "" {
+				return successor
+			}
+		}
+	}
+	return ""
+}
+
+// cleanSuccessor strips punctuation and URL decoration from a candidate successor and
+// returns it if it is a valid import path on a domain, or "" otherwise
+func cleanSuccessor(candidate string) string {
+	candidate = strings.Trim(candidate, "`'\"()[]<>")
+	candidate = strings.TrimRight(candidate, ".,;:!")
+	candidate = strings.TrimPrefix(candidate, "https://")
+	candidate = strings.TrimPrefix(candidate, "http://")
+	candidate = strings.TrimSuffix(strings.TrimSuffix(candidate, "/"), ".git")
+
+	host, _, hasPath := strings.Cut(candidate, "/")
+	if !hasPath || !strings.Contains(host, ".") {
+		return ""
+	}
+	if module.CheckImportPath(candidate) != nil {
+		return ""
+	}
+	return candidate
+}
@@ -0,0 +1,56 @@
+package replacements
+
+import "testing"
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		module string
+		want   string
+	}{
+		{"github.com/golang/protobuf", "google.golang.org/protobuf: the APIv2 protobuf runtime"},
+		{"github.com/pkg/errors", "errors (standard library): wrap with fmt.Errorf and %w, inspect with errors.Is and errors.As"},
+		{"github.com/gobuffalo/packr/v2", "embed (standard library)"},
+		{"github.com/google/uuid", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.module, func(t *testing.T) {
+			replacement, ok := Lookup(tt.module)
+			if tt.want == "" {
+				if ok {
+					t.Errorf("Lookup() = %v, want no replacement", replacement)
+				}
+				return
+			}
+			if !ok || replacement.String() != tt.want {
+				t.Errorf("Lookup() = %v, %v; want %q", replacement, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSuccessor(t *testing.T) {
+	tests := []struct {
+		notice string
+		want   string
+	}{
+		{"This repository has moved to https://github.com/new-org/lib.", "github.com/new-org/lib"},
+		{"Moved to: gitlab.com/team/lib", "gitlab.com/team/lib"},
+		{"Deprecated: use google.golang.org/protobuf instead.", "google.golang.org/protobuf"},
+		{"Deprecated in favor of `example.com/lib/v2`", "example.com/lib/v2"},
+		{"ARCHIVED - now maintained at https://codeberg.org/owner/lib/", "codeberg.org/owner/lib"},
+		{"Please use github.com/golang-jwt/jwt", "github.com/golang-jwt/jwt"},
+		// Successors must be import paths on a domain
+		{"Use the standard library instead", ""},
+		{"Moved to a new home", ""},
+		{"A fast JSON parser", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.notice, func(t *testing.T) {
+			if got := ParseSuccessor(tt.notice); got != tt.want {
+				t.Errorf("ParseSuccessor(%q) = %q, want %q", tt.notice, got, tt.want)
+			}
+		})
+	}
+}
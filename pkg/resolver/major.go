@@ -0,0 +1,62 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/mod/module"
+)
+
+// maxMajorProbes bounds how many later major versions are probed for a module
+const maxMajorProbes = 10
+
+// MajorVersion is the newest release of a later major version of a module
+type MajorVersion struct {
+	VersionInfo
+	Path string
+}
+
+// GetLatestMajorVersion probes the module proxy for later major versions of modulePath
+// (/v2, /v3, ... or .v2, .v3 for gopkg.in) and returns the newest one with a tagged
+// release, or nil if there is none. Probing stops at the first path the proxy doesn't know.
+func (r *Resolver) GetLatestMajorVersion(ctx context.Context, modulePath string) (*MajorVersion, error) {
+	prefix, pathMajor, ok := module.SplitPathVersion(modulePath)
+	if !ok {
+		return nil, fmt.Errorf("invalid module path %q", modulePath)
+	}
+
+	major := 1
+	if pathMajor != "" {
+		n, err := strconv.Atoi(strings.TrimLeft(pathMajor, "/.v"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid major version suffix in %q", modulePath)
+		}
+		major = n
+	}
+
+	separator := "/v"
+	if strings.HasPrefix(modulePath, "gopkg.in/") {
+		separator = ".v"
+	}
+
+	var latest *MajorVersion
+	for next := major + 1; next <= major+maxMajorProbes; next++ {
+		candidate := fmt.Sprintf("%s%s%d", prefix, separator, next)
+		info, err := r.GetLatestInfo(ctx, candidate)
+		if errors.Is(err, ErrNotInProxy) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// A major version with only pseudo-versions has not been released yet
+		if !module.IsPseudoVersion(info.Version) {
+			latest = &MajorVersion{VersionInfo: *info, Path: candidate}
+		}
+	}
+	return latest, nil
+}
@@ -0,0 +1,50 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetLatestMajorVersion(t *testing.T) {
+	r := newProxyTestResolver(t, map[string]string{
+		"/example.com/lib/v2/@latest":   `{"Version":"v2.5.0","Time":"2021-01-01T00:00:00Z"}`,
+		"/example.com/lib/v3/@latest":   `{"Version":"v3.1.2","Time":"2023-01-01T00:00:00Z"}`,
+		"/example.com/lib/v4/@latest":   `{"Version":"v4.0.0-20240101000000-abcdefabcdef","Time":"2024-01-01T00:00:00Z"}`,
+		"/gopkg.in/yaml.v3/@latest":     `{"Version":"v3.0.1","Time":"2022-05-27T00:00:00Z"}`,
+		"/example.com/single/@latest":   `{"Version":"v1.0.0","Time":"2020-01-01T00:00:00Z"}`,
+		"/example.com/other/v3/@latest": `{"Version":"v3.0.0","Time":"2023-01-01T00:00:00Z"}`,
+	})
+
+	tests := []struct {
+		module      string
+		wantPath    string
+		wantVersion string
+	}{
+		// v4 only has pseudo-versions, and probing stops at the missing v5
+		{"example.com/lib", "example.com/lib/v3", "v3.1.2"},
+		{"example.com/lib/v2", "example.com/lib/v3", "v3.1.2"},
+		{"example.com/lib/v3", "", ""},
+		{"gopkg.in/yaml.v2", "gopkg.in/yaml.v3", "v3.0.1"},
+		{"example.com/single", "", ""},
+		// A gap in major versions stops the probe
+		{"example.com/other", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.module, func(t *testing.T) {
+			major, err := r.GetLatestMajorVersion(context.Background(), tt.module)
+			if err != nil {
+				t.Fatalf("GetLatestMajorVersion() error = %v", err)
+			}
+			if tt.wantPath == "" {
+				if major != nil {
+					t.Errorf("GetLatestMajorVersion() = %+v, want nil", major)
+				}
+				return
+			}
+			if major == nil || major.Path != tt.wantPath || major.Version != tt.wantVersion {
+				t.Errorf("GetLatestMajorVersion() = %+v, want %s@%s", major, tt.wantPath, tt.wantVersion)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package resolver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+)
+
+// DefaultProxyURL is the module proxy used when none is configured
+const DefaultProxyURL = "https://proxy.golang.org"
+
+// ErrNotInProxy is returned when the module proxy has no record of a module or version
+var ErrNotInProxy = errors.New("not found in Go module proxy")
+
+// proxyStatusError is returned when the module proxy answers with an unexpected status
+type proxyStatusError struct {
+	code int
+}
+
+func (e *proxyStatusError) Error() string {
+	return fmt.Sprintf("go module proxy returned status %d", e.code)
+}
+
+// VersionInfo is the document served by the module proxy for @latest and @v/<version>.info
+type VersionInfo struct {
+	Time    time.Time
+	Version string
+}
+
+// LatestModule describes the latest version of a module as published on the module proxy,
+// together with the deprecation and retraction data declared in its go.mod
+type LatestModule struct {
+	Retractions []RetractionRange
+	Deprecated  string
+	VersionInfo
+}
+
+// SetProxyURL points the resolver at a different module proxy, such as a corporate mirror.
+// A file:// URL serves the proxy protocol from a local directory, as GOPROXY does.
+func (r *Resolver) SetProxyURL(proxyURL string) {
+	r.proxyURL = strings.TrimSuffix(proxyURL, "/")
+}
+
+// ProxyFromEnv returns the first proxy in the GOPROXY environment variable the resolver can
+// query, skipping "direct" and "off", or DefaultProxyURL if there is none
+func ProxyFromEnv() string {
+	for _, entry := range strings.FieldsFunc(os.Getenv("GOPROXY"), func(r rune) bool { return r == ',' || r == '|' }) {
+		entry = strings.TrimSpace(entry)
+		if strings.HasPrefix(entry, "https://") || strings.HasPrefix(entry, "http://") || strings.HasPrefix(entry, "file://") {
+			return entry
+		}
+	}
+	return DefaultProxyURL
+}
+
+// GetLatestInfo returns the latest version of a module and when it was published. Proxies
+// without @latest, such as a GOMODCACHE's cache/download directory, fall back to the
+// highest version in @v/list, as the go command does.
+func (r *Resolver) GetLatestInfo(ctx context.Context, modulePath string) (*VersionInfo, error) {
+	escapedPath, err := module.EscapePath(modulePath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid module path %q: %w", modulePath, err)
+	}
+
+	body, err := r.fetchProxy(ctx, escapedPath+"/@latest")
+	if errors.Is(err, ErrNotInProxy) {
+		return r.latestListed(ctx, modulePath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return parseVersionInfo(body)
+}
+
+// latestListed returns the highest release in the module's version list, or the highest
+// prerelease if there are no releases
+func (r *Resolver) latestListed(ctx context.Context, modulePath string) (*VersionInfo, error) {
+	versions, err := r.ListVersions(ctx, modulePath)
+	if err != nil {
+		return nil, err
+	}
+	candidates := releaseCandidates(versions, false)
+	if len(candidates) == 0 {
+		return nil, ErrNotInProxy
+	}
+	return r.GetVersionInfo(ctx, modulePath, candidates[len(candidates)-1])
+}
+
+// GetVersionInfo returns when a specific module version was published
+func (r *Resolver) GetVersionInfo(ctx context.Context, modulePath, version string) (*VersionInfo, error) {
+	escapedPath, err := module.EscapePath(modulePath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid module path %q: %w", modulePath, err)
+	}
+	escapedVersion, err := module.EscapeVersion(version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %q: %w", version, err)
+	}
+
+	body, err := r.fetchProxy(ctx, escapedPath+"/@v/"+escapedVersion+".info")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseVersionInfo(body)
+}
+
+// GetLatestModule fetches the latest version of a module and parses the
+// deprecation notice and retract directives from its go.mod
+func (r *Resolver) GetLatestModule(ctx context.Context, modulePath string) (*LatestModule, error) {
+	latest, err := r.GetLatestInfo(ctx, modulePath)
+	if err != nil {
+		return nil, err
+	}
+
+	escapedPath, _ := module.EscapePath(modulePath)
+	escapedVersion, err := module.EscapeVersion(latest.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %q: %w", latest.Version, err)
+	}
+
+	goMod, err := r.fetchProxy(ctx, escapedPath+"/@v/"+escapedVersion+".mod")
+	if err != nil {
+		return nil, err
+	}
+
+	info := &LatestModule{VersionInfo: *latest}
+
+	// A go.mod the proxy synthesized for a pre-modules repository has nothing to parse
+	if f, parseErr := modfile.ParseLax("go.mod", goMod, nil); parseErr == nil && f.Module != nil {
+		info.Deprecated = f.Module.Deprecated
+	}
+
+	retractions, err := parseRetractions(bytes.NewReader(goMod))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse retractions: %w", err)
+	}
+	info.Retractions = retractions
+
+	return info, nil
+}
+
+// GetModFile returns the go.mod of a module version as published on the module proxy
+func (r *Resolver) GetModFile(ctx context.Context, modulePath, version string) (*modfile.File, error) {
+	escapedPath, err := module.EscapePath(modulePath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid module path %q: %w", modulePath, err)
+	}
+	escapedVersion, err := module.EscapeVersion(version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %q: %w", version, err)
+	}
+
+	goMod, err := r.fetchProxy(ctx, escapedPath+"/@v/"+escapedVersion+".mod")
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := modfile.ParseLax("go.mod", goMod, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse go.mod of %s@%s: %w", modulePath, version, err)
+	}
+	return f, nil
+}
+
+// fetchProxy performs a GET against the module proxy and returns the response body
+func (r *Resolver) fetchProxy(ctx context.Context, path string) ([]byte, error) {
+	if dir, ok := strings.CutPrefix(r.proxyURL, "file://"); ok {
+		return readLocalProxy(dir, path)
+	}
+
+	proxyURL := r.proxyURL + "/" + path
+
+	req, err := http.NewRequestWithContext(ctx, "GET", proxyURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query module proxy: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusGone:
+		return nil, ErrNotInProxy
+	default:
+		return nil, &proxyStatusError{code: resp.StatusCode}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read module proxy response: %w", err)
+	}
+	return body, nil
+}
+
+// readLocalProxy reads a file from a module proxy laid out on disk, such as a GOMODCACHE's
+// cache/download directory
+func readLocalProxy(dir, path string) ([]byte, error) {
+	body, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(path))) //nolint:gosec // G304: the proxy directory is configured by the user
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotInProxy
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read local module proxy: %w", err)
+	}
+	return body, nil
+}
+
+// parseVersionInfo decodes a module proxy version document
+func parseVersionInfo(body []byte) (*VersionInfo, error) {
+	var info VersionInfo
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, fmt.Errorf("failed to parse module proxy response: %w", err)
+	}
+	if info.Version == "" {
+		return nil, errors.New("module proxy response has no version")
+	}
+	return &info, nil
+}
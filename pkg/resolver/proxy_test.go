@@ -0,0 +1,211 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newProxyTestResolver returns a resolver whose module proxy is an httptest server
+// serving the given path -> body map; unknown paths return 404
+func newProxyTestResolver(t *testing.T, files map[string]string) *Resolver {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	r := NewResolver(5 * time.Second)
+	r.SetProxyURL(server.URL)
+	return r
+}
+
+func TestGetLatestModule(t *testing.T) {
+	r := newProxyTestResolver(t, map[string]string{
+		// Upper-case letters are escaped as !<lower> by the proxy protocol
+		"/github.com/!old/lib/@latest": `{"Version":"v1.4.0","Time":"2021-03-04T05:06:07Z"}`,
+		"/github.com/!old/lib/@v/v1.4.0.mod": `// Deprecated: use example.com/newlib instead.
+module github.com/Old/lib
+
+go 1.16
+
+retract v1.3.0 // Broken build
+`,
+		"/github.com/!old/lib/@v/v1.2.0.info": `{"Version":"v1.2.0","Time":"2020-01-02T00:00:00Z"}`,
+	})
+	ctx := context.Background()
+
+	latest, err := r.GetLatestModule(ctx, "github.com/Old/lib")
+	if err != nil {
+		t.Fatalf("GetLatestModule() error = %v", err)
+	}
+	if latest.Version != "v1.4.0" {
+		t.Errorf("Version = %q, want v1.4.0", latest.Version)
+	}
+	if want := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC); !latest.Time.Equal(want) {
+		t.Errorf("Time = %v, want %v", latest.Time, want)
+	}
+	if latest.Deprecated != "use example.com/newlib instead." {
+		t.Errorf("Deprecated = %q", latest.Deprecated)
+	}
+	if retracted, reason := IsVersionRetracted("v1.3.0", latest.Retractions); !retracted || reason != "Broken build" {
+		t.Errorf("IsVersionRetracted(v1.3.0) = %v, %q; want true, %q", retracted, reason, "Broken build")
+	}
+
+	info, err := r.GetVersionInfo(ctx, "github.com/Old/lib", "v1.2.0")
+	if err != nil {
+		t.Fatalf("GetVersionInfo() error = %v", err)
+	}
+	if info.Time.Year() != 2020 {
+		t.Errorf("GetVersionInfo().Time = %v, want 2020", info.Time)
+	}
+
+	if _, err := r.GetLatestModule(ctx, "github.com/missing/lib"); !errors.Is(err, ErrNotInProxy) {
+		t.Errorf("GetLatestModule() for unknown module error = %v, want ErrNotInProxy", err)
+	}
+}
+
+func TestCheckRetraction_NotInProxy(t *testing.T) {
+	r := newProxyTestResolver(t, nil)
+
+	info, err := r.CheckRetraction(context.Background(), "example.com/private", "v1.0.0")
+	if err != nil {
+		t.Fatalf("CheckRetraction() error = %v", err)
+	}
+	if info.IsRetracted {
+		t.Error("module missing from the proxy should not be reported as retracted")
+	}
+}
+
+func TestCheckRetraction_ProxyError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(server.Close)
+	r := NewResolver(5 * time.Second)
+	r.SetProxyURL(server.URL)
+
+	// A flaky proxy leaves the dependency unchecked rather than failing it
+	info, err := r.CheckRetraction(context.Background(), "example.com/lib", "v1.0.0")
+	if err != nil {
+		t.Fatalf("CheckRetraction() error = %v", err)
+	}
+	if info.IsRetracted {
+		t.Error("a proxy error should not be reported as a retraction")
+	}
+}
+
+func TestLocalProxy(t *testing.T) {
+	dir := t.TempDir()
+	versionDir := filepath.Join(dir, "example.com", "!my!lib", "@v")
+	if err := os.MkdirAll(versionDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(versionDir, "v1.2.0.mod"), []byte("module example.com/MyLib\n\nrequire example.com/dep v0.3.0\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewResolver(5 * time.Second)
+	r.SetProxyURL("file://" + dir)
+
+	f, err := r.GetModFile(context.Background(), "example.com/MyLib", "v1.2.0")
+	if err != nil {
+		t.Fatalf("GetModFile() error = %v", err)
+	}
+	if len(f.Require) != 1 || f.Require[0].Mod.Path != "example.com/dep" {
+		t.Errorf("Require = %v, want example.com/dep", f.Require)
+	}
+
+	if _, err := r.GetModFile(context.Background(), "example.com/MyLib", "v9.9.9"); !errors.Is(err, ErrNotInProxy) {
+		t.Errorf("GetModFile() for a missing version error = %v, want ErrNotInProxy", err)
+	}
+}
+
+func TestLocalProxy_ModuleCache(t *testing.T) {
+	// A GOMODCACHE cache/download tree as left by go mod download: version lists, .info,
+	// .mod, .zip and .ziphash files and download locks, but no @latest
+	dir := t.TempDir()
+	files := map[string]string{
+		"example.com/lib/@v/list":               "v1.2.0\nv1.10.0\nv1.11.0-rc.1\n",
+		"example.com/lib/@v/v1.2.0.info":        `{"Version":"v1.2.0","Time":"2022-01-01T00:00:00Z"}`,
+		"example.com/lib/@v/v1.2.0.mod":         "module example.com/lib\n",
+		"example.com/lib/@v/v1.10.0.info":       `{"Version":"v1.10.0","Time":"2023-01-01T00:00:00Z","Origin":{"VCS":"git"}}`,
+		"example.com/lib/@v/v1.10.0.mod":        "module example.com/lib\n\nretract v1.2.0\n",
+		"example.com/lib/@v/v1.10.0.zip":        "PK",
+		"example.com/lib/@v/v1.10.0.lock":       "",
+		"example.com/lib/@v/v1.10.0.ziphash":    "h1:AAAA=",
+		"example.com/lib/@v/v1.11.0-rc.1.info":  `{"Version":"v1.11.0-rc.1","Time":"2024-01-01T00:00:00Z"}`,
+		"example.com/beta/@v/list":              "v0.1.0-alpha\n",
+		"example.com/beta/@v/v0.1.0-alpha.info": `{"Version":"v0.1.0-alpha","Time":"2024-02-01T00:00:00Z"}`,
+		"example.com/pseudo/@v/list":            "",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r := NewResolver(5 * time.Second)
+	r.SetProxyURL("file://" + dir)
+	ctx := context.Background()
+
+	// The highest release in semver order, not the prerelease or the last listed
+	latest, err := r.GetLatestModule(ctx, "example.com/lib")
+	if err != nil {
+		t.Fatalf("GetLatestModule() error = %v", err)
+	}
+	if latest.Version != "v1.10.0" || latest.Time.Year() != 2023 {
+		t.Errorf("latest = %s at %v, want v1.10.0 from 2023", latest.Version, latest.Time)
+	}
+	if len(latest.Retractions) != 1 {
+		t.Errorf("Retractions = %v, want the one in v1.10.0's go.mod", latest.Retractions)
+	}
+
+	// Prereleases only when there is nothing else
+	if info, err := r.GetLatestInfo(ctx, "example.com/beta"); err != nil || info.Version != "v0.1.0-alpha" {
+		t.Errorf("GetLatestInfo(beta) = %v, %v; want v0.1.0-alpha", info, err)
+	}
+
+	for _, modulePath := range []string{"example.com/pseudo", "example.com/missing"} {
+		if _, err := r.GetLatestInfo(ctx, modulePath); !errors.Is(err, ErrNotInProxy) {
+			t.Errorf("GetLatestInfo(%s) error = %v, want ErrNotInProxy", modulePath, err)
+		}
+	}
+}
+
+func TestProxyFromEnv(t *testing.T) {
+	tests := []struct {
+		goproxy string
+		want    string
+	}{
+		{"", DefaultProxyURL},
+		{"direct", DefaultProxyURL},
+		{"off", DefaultProxyURL},
+		{"https://goproxy.corp.example,direct", "https://goproxy.corp.example"},
+		{"direct|file:///srv/modules", "file:///srv/modules"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.goproxy, func(t *testing.T) {
+			t.Setenv("GOPROXY", tt.goproxy)
+			if got := ProxyFromEnv(); got != tt.want {
+				t.Errorf("ProxyFromEnv() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
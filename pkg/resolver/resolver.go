@@ -2,13 +2,14 @@ package resolver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
-	"net/url"
 	"time"
 
 	"github.com/johnsaigle/go-unmaintained/pkg/parser"
 	"github.com/johnsaigle/go-unmaintained/pkg/types"
+	"golang.org/x/mod/module"
 )
 
 // ResolverResult contains information about a resolved module
@@ -35,6 +36,7 @@ const (
 // Resolver handles resolution of non-GitHub Go modules
 type Resolver struct {
 	httpClient *http.Client
+	proxyURL   string
 	timeout    time.Duration
 }
 
@@ -52,7 +54,8 @@ func NewResolver(timeout time.Duration) *Resolver {
 				return http.ErrUseLastResponse
 			},
 		},
-		timeout: timeout,
+		proxyURL: DefaultProxyURL,
+		timeout:  timeout,
 	}
 }
 
@@ -90,39 +93,36 @@ func (r *Resolver) ResolveModule(ctx context.Context, modulePath string) *Resolv
 	return result
 }
 
-// tryGoModuleProxy attempts to resolve the module using the Go module proxy
+// tryGoModuleProxy attempts to resolve the module using the configured Go module proxy.
+// It returns nil when the proxy cannot be reached, so other strategies are tried.
 func (r *Resolver) tryGoModuleProxy(ctx context.Context, modulePath string) *ResolverResult {
-	// Try the default Go proxy
-	proxyURL := fmt.Sprintf("https://proxy.golang.org/%s/@v/list", url.QueryEscape(modulePath))
-
-	req, err := http.NewRequestWithContext(ctx, "GET", proxyURL, nil)
-	if err != nil {
-		return nil
-	}
-
-	resp, err := r.httpClient.Do(req)
+	escapedPath, err := module.EscapePath(modulePath)
 	if err != nil {
 		return nil
 	}
-	defer resp.Body.Close()
+	path := escapedPath + "/@v/list"
 
 	result := &ResolverResult{
 		ModulePath:      modulePath,
-		ActualURL:       proxyURL,
+		ActualURL:       r.proxyURL + "/" + path,
 		HostingProvider: "Go Module Proxy",
 		Status:          StatusUnknown,
 	}
 
-	switch resp.StatusCode {
-	case http.StatusOK:
+	_, err = r.fetchProxy(ctx, path)
+	var statusErr *proxyStatusError
+	switch {
+	case err == nil:
 		result.Status = StatusActive
 		result.Details = "Available in Go module proxy"
-	case http.StatusNotFound, http.StatusGone:
+	case errors.Is(err, ErrNotInProxy):
 		result.Status = StatusNotFound
 		result.Details = "Not found in Go module proxy"
-	default:
+	case errors.As(err, &statusErr):
 		result.Status = StatusUnavailable
-		result.Details = fmt.Sprintf("Go module proxy returned status %d", resp.StatusCode)
+		result.Details = fmt.Sprintf("Go module proxy returned status %d", statusErr.code)
+	default:
+		return nil
 	}
 
 	return result
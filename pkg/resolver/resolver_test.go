@@ -1,7 +1,11 @@
 package resolver
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/johnsaigle/go-unmaintained/pkg/parser"
 )
@@ -151,3 +155,44 @@ func TestNewResolver(t *testing.T) {
 		t.Errorf("timeout = %v, want 5", r2.timeout)
 	}
 }
+
+func TestTryGoModuleProxy(t *testing.T) {
+	dir := t.TempDir()
+	versionDir := filepath.Join(dir, "example.com", "!my!lib", "@v")
+	if err := os.MkdirAll(versionDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(versionDir, "list"), []byte("v1.0.0\nv1.2.0\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	local := NewResolver(5 * time.Second)
+	local.SetProxyURL("file://" + dir)
+
+	remote := newProxyTestResolver(t, map[string]string{
+		"/example.com/!my!lib/@v/list": "v1.0.0\nv1.2.0\n",
+	})
+
+	tests := []struct {
+		name       string
+		resolver   *Resolver
+		module     string
+		wantStatus ModuleStatus
+	}{
+		{"http found", remote, "example.com/MyLib", StatusActive},
+		{"http missing", remote, "example.com/gone", StatusNotFound},
+		{"file found", local, "example.com/MyLib", StatusActive},
+		{"file missing", local, "example.com/gone", StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.resolver.tryGoModuleProxy(context.Background(), tt.module)
+			if result == nil {
+				t.Fatal("tryGoModuleProxy() = nil")
+			}
+			if result.Status != tt.wantStatus {
+				t.Errorf("Status = %q (%s), want %q", result.Status, result.Details, tt.wantStatus)
+			}
+		})
+	}
+}
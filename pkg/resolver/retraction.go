@@ -3,11 +3,8 @@ package resolver
 import (
 	"bufio"
 	"context"
-	"fmt"
+	"errors"
 	"io"
-	"net/http"
-	"net/url"
-	"regexp"
 	"strings"
 
 	"golang.org/x/mod/semver"
@@ -34,78 +31,31 @@ func (r *Resolver) CheckRetraction(ctx context.Context, modulePath, version stri
 		Ranges:      []RetractionRange{},
 	}
 
-	// Fetch the @latest version's go.mod from the module proxy
-	proxyURL := fmt.Sprintf("https://proxy.golang.org/%s/@latest", url.QueryEscape(modulePath))
-
-	req, err := http.NewRequestWithContext(ctx, "GET", proxyURL, nil)
-	if err != nil {
-		return info, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := r.httpClient.Do(req)
-	if err != nil {
-		return info, fmt.Errorf("failed to fetch @latest: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
+	latest, err := r.GetLatestModule(ctx, modulePath)
+	var statusErr *proxyStatusError
+	if errors.Is(err, ErrNotInProxy) || errors.As(err, &statusErr) {
 		// Module doesn't exist or proxy error - not an error for us
 		return info, nil
 	}
-
-	// Read the response to get the latest version
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return info, fmt.Errorf("failed to read @latest response: %w", err)
-	}
-
-	// Parse JSON to get the version (simple parsing)
-	// Response format: {"Version":"v1.2.3","Time":"2021-01-01T00:00:00Z"}
-	versionPattern := regexp.MustCompile(`"Version"\s*:\s*"([^"]+)"`)
-	matches := versionPattern.FindStringSubmatch(string(body))
-	if len(matches) < 2 {
-		return info, nil
-	}
-
-	latestVersion := matches[1]
-
-	// Now fetch the go.mod file for the latest version
-	goModURL := fmt.Sprintf("https://proxy.golang.org/%s/@v/%s.mod",
-		url.QueryEscape(modulePath), url.QueryEscape(latestVersion))
-
-	req, err = http.NewRequestWithContext(ctx, "GET", goModURL, nil)
-	if err != nil {
-		return info, fmt.Errorf("failed to create go.mod request: %w", err)
-	}
-
-	resp, err = r.httpClient.Do(req)
 	if err != nil {
-		return info, fmt.Errorf("failed to fetch go.mod: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return info, nil
+		return info, err
 	}
 
-	// Parse the go.mod file for retract directives
-	retractions, err := parseRetractions(resp.Body)
-	if err != nil {
-		return info, fmt.Errorf("failed to parse retractions: %w", err)
-	}
+	info.Ranges = latest.Retractions
+	info.IsRetracted, info.Reason = IsVersionRetracted(version, latest.Retractions)
 
-	info.Ranges = retractions
+	return info, nil
+}
 
-	// Check if the specified version falls within any retraction range
+// IsVersionRetracted reports whether version falls within any of the retraction ranges,
+// returning the reason given for the first matching range
+func IsVersionRetracted(version string, retractions []RetractionRange) (bool, string) {
 	for _, retract := range retractions {
 		if versionInRange(version, retract.Low, retract.High) {
-			info.IsRetracted = true
-			info.Reason = retract.Reason
-			break
+			return true, retract.Reason
 		}
 	}
-
-	return info, nil
+	return false, ""
 }
 
 // parseRetractions parses retract directives from a go.mod file
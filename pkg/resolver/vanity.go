@@ -0,0 +1,90 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// ImportMeta holds the contents of a go-import meta tag
+// See https://go.dev/ref/mod#vcs-find
+type ImportMeta struct {
+	Prefix   string // Import path prefix the repository serves
+	VCS      string // Version control system, e.g. "git"
+	RepoRoot string // Repository URL, e.g. https://go.googlesource.com/crypto
+}
+
+var (
+	metaTagPattern     = regexp.MustCompile(`(?is)<meta\s[^>]*>`)
+	metaNamePattern    = regexp.MustCompile(`(?is)\bname\s*=\s*["']go-import["']`)
+	metaContentPattern = regexp.MustCompile(`(?is)\bcontent\s*=\s*["']([^"']*)["']`)
+)
+
+// ResolveImport fetches the go-import meta tag for a vanity module path
+// to discover the repository that actually hosts it
+func (r *Resolver) ResolveImport(ctx context.Context, modulePath string) (*ImportMeta, error) {
+	url := fmt.Sprintf("https://%s?go-get=1", modulePath)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Vanity servers commonly redirect to the canonical page, so unlike the
+	// other resolver requests we follow redirects here
+	client := *r.httpClient
+	client.CheckRedirect = nil
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch go-import meta: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vanity URL returned status %d", resp.StatusCode)
+	}
+
+	return parseGoImportMeta(io.LimitReader(resp.Body, 1<<20), modulePath)
+}
+
+// parseGoImportMeta finds the go-import meta tag whose prefix matches the module path
+func parseGoImportMeta(r io.Reader, modulePath string) (*ImportMeta, error) {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vanity page: %w", err)
+	}
+
+	for _, tag := range metaTagPattern.FindAllString(string(body), -1) {
+		if !metaNamePattern.MatchString(tag) {
+			continue
+		}
+
+		matches := metaContentPattern.FindStringSubmatch(tag)
+		if len(matches) < 2 {
+			continue
+		}
+
+		fields := strings.Fields(html.UnescapeString(matches[1]))
+		if len(fields) != 3 {
+			continue
+		}
+
+		prefix, vcs, repoRoot := fields[0], fields[1], fields[2]
+		// Skip module proxy ("mod") entries; we want the source repository
+		if vcs == "mod" {
+			continue
+		}
+		if modulePath != prefix && !strings.HasPrefix(modulePath, prefix+"/") {
+			continue
+		}
+
+		return &ImportMeta{Prefix: prefix, VCS: vcs, RepoRoot: repoRoot}, nil
+	}
+
+	return nil, fmt.Errorf("no go-import meta tag found for %s", modulePath)
+}
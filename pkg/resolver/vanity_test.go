@@ -0,0 +1,72 @@
+package resolver
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseGoImportMeta(t *testing.T) {
+	tests := []struct {
+		name         string
+		page         string
+		modulePath   string
+		wantRepoRoot string
+		wantErr      bool
+	}{
+		{
+			name:         "googlesource vanity",
+			page:         `<html><head><meta name="go-import" content="golang.org/x/crypto git https://go.googlesource.com/crypto"></head></html>`,
+			modulePath:   "golang.org/x/crypto",
+			wantRepoRoot: "https://go.googlesource.com/crypto",
+		},
+		{
+			name:         "subpackage of prefix",
+			page:         `<meta name="go-import" content="go.chromium.org/luci git https://chromium.googlesource.com/infra/luci/luci-go">`,
+			modulePath:   "go.chromium.org/luci/common",
+			wantRepoRoot: "https://chromium.googlesource.com/infra/luci/luci-go",
+		},
+		{
+			name: "skips mod entries and attribute order does not matter",
+			page: `<meta content="example.com/lib mod https://proxy.example.com" name="go-import">
+<meta content='example.com/lib git https://git.sr.ht/~user/lib' name='go-import'/>`,
+			modulePath:   "example.com/lib",
+			wantRepoRoot: "https://git.sr.ht/~user/lib",
+		},
+		{
+			name:       "prefix does not match module",
+			page:       `<meta name="go-import" content="example.com/other git https://example.com/other">`,
+			modulePath: "example.com/lib",
+			wantErr:    true,
+		},
+		{
+			name:       "prefix is not a path boundary",
+			page:       `<meta name="go-import" content="example.com/li git https://example.com/li">`,
+			modulePath: "example.com/lib",
+			wantErr:    true,
+		},
+		{
+			name:       "no meta tag",
+			page:       `<html><head><title>nothing</title></head></html>`,
+			modulePath: "example.com/lib",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta, err := parseGoImportMeta(strings.NewReader(tt.page), tt.modulePath)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %+v", meta)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseGoImportMeta() error: %v", err)
+			}
+			if meta.RepoRoot != tt.wantRepoRoot {
+				t.Errorf("RepoRoot = %q, want %q", meta.RepoRoot, tt.wantRepoRoot)
+			}
+		})
+	}
+}
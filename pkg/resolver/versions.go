@@ -0,0 +1,128 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// maxLagLookups bounds the .info requests made to date releases newer than a pseudo-version
+const maxLagLookups = 20
+
+// VersionLag describes how far a version is behind the latest release of its module path
+type VersionLag struct {
+	LatestTime time.Time
+	Latest     string
+	// AgeGap is the time between the version in use and Latest
+	AgeGap time.Duration
+	// ReleasesBehind counts releases newer than the version in use
+	ReleasesBehind int
+}
+
+// ListVersions returns the tagged versions the module proxy knows for modulePath, in
+// ascending semver order. Pseudo-versions are never listed.
+func (r *Resolver) ListVersions(ctx context.Context, modulePath string) ([]string, error) {
+	escapedPath, err := module.EscapePath(modulePath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid module path %q: %w", modulePath, err)
+	}
+
+	body, err := r.fetchProxy(ctx, escapedPath+"/@v/list")
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []string
+	for _, line := range strings.Split(string(body), "\n") {
+		if version := strings.TrimSpace(line); semver.IsValid(version) {
+			versions = append(versions, version)
+		}
+	}
+	semver.Sort(versions)
+	return versions, nil
+}
+
+// GetVersionLag compares version with the releases of exactly modulePath. Prereleases only
+// count when version is itself a prerelease, or when the module has nothing else. A
+// pseudo-version is compared by its commit timestamp, so releases tagged before that commit
+// don't count as newer. Latest is empty if the module has no tagged versions.
+func (r *Resolver) GetVersionLag(ctx context.Context, modulePath, version string) (*VersionLag, error) {
+	versions, err := r.ListVersions(ctx, modulePath)
+	if err != nil {
+		return nil, err
+	}
+
+	isPseudo := module.IsPseudoVersion(version)
+	candidates := releaseCandidates(versions, semver.Prerelease(version) != "" && !isPseudo)
+	if len(candidates) == 0 {
+		return &VersionLag{}, nil
+	}
+
+	lag := &VersionLag{Latest: candidates[len(candidates)-1]}
+	latestInfo, err := r.GetVersionInfo(ctx, modulePath, lag.Latest)
+	if err != nil {
+		return nil, err
+	}
+	lag.LatestTime = latestInfo.Time
+
+	var currentTime time.Time
+	if isPseudo {
+		currentTime, err = module.PseudoVersionTime(version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pseudo-version %q: %w", version, err)
+		}
+		lag.ReleasesBehind = r.releasesAfter(ctx, modulePath, candidates, currentTime)
+	} else {
+		if info, infoErr := r.GetVersionInfo(ctx, modulePath, version); infoErr == nil {
+			currentTime = info.Time
+		}
+		for _, candidate := range candidates {
+			if semver.Compare(candidate, version) > 0 {
+				lag.ReleasesBehind++
+			}
+		}
+	}
+
+	if !currentTime.IsZero() && lag.LatestTime.After(currentTime) {
+		lag.AgeGap = lag.LatestTime.Sub(currentTime)
+	}
+	return lag, nil
+}
+
+// releaseCandidates returns the versions a module user would upgrade to. Prereleases are
+// left out unless includePrerelease is set or there are no other versions.
+func releaseCandidates(versions []string, includePrerelease bool) []string {
+	if includePrerelease {
+		return versions
+	}
+
+	var releases []string
+	for _, version := range versions {
+		if semver.Prerelease(version) == "" {
+			releases = append(releases, version)
+		}
+	}
+	if len(releases) == 0 {
+		return versions
+	}
+	return releases
+}
+
+// releasesAfter counts the candidates published after the given commit time, newest first.
+// Releases are assumed to be tagged in version order, so the walk stops at the first older
+// one; it also stops after maxLagLookups requests.
+func (r *Resolver) releasesAfter(ctx context.Context, modulePath string, candidates []string, commitTime time.Time) int {
+	count := 0
+	for i := len(candidates) - 1; i >= 0 && count < maxLagLookups; i-- {
+		info, err := r.GetVersionInfo(ctx, modulePath, candidates[i])
+		if err != nil || !info.Time.After(commitTime) {
+			break
+		}
+		count++
+	}
+	return count
+}
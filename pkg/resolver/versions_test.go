@@ -0,0 +1,55 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestGetVersionLag(t *testing.T) {
+	r := newProxyTestResolver(t, map[string]string{
+		"/example.com/lib/@v/list":                     "v1.0.0\nv1.1.0\nv1.2.0-rc.1\nv1.1.1\n",
+		"/example.com/lib/@v/v1.0.0.info":              `{"Version":"v1.0.0","Time":"2022-01-01T00:00:00Z"}`,
+		"/example.com/lib/@v/v1.1.0.info":              `{"Version":"v1.1.0","Time":"2022-06-01T00:00:00Z"}`,
+		"/example.com/lib/@v/v1.1.1.info":              `{"Version":"v1.1.1","Time":"2022-07-01T00:00:00Z"}`,
+		"/example.com/lib/@v/v1.2.0-rc.1.info":         `{"Version":"v1.2.0-rc.1","Time":"2022-08-01T00:00:00Z"}`,
+		"/example.com/lib/@v/v1.2.0-beta.1.info":       `{"Version":"v1.2.0-beta.1","Time":"2022-07-15T00:00:00Z"}`,
+		"/example.com/old/@v/list":                     "v2.0.0+incompatible\nv1.5.0\nv3.1.0+incompatible\n",
+		"/example.com/old/@v/v1.5.0.info":              `{"Version":"v1.5.0","Time":"2019-01-01T00:00:00Z"}`,
+		"/example.com/old/@v/v3.1.0+incompatible.info": `{"Version":"v3.1.0+incompatible","Time":"2020-01-01T00:00:00Z"}`,
+		"/example.com/untagged/@v/list":                "",
+	})
+
+	tests := []struct {
+		name       string
+		module     string
+		version    string
+		wantLatest string
+		wantBehind int
+		wantGap    time.Duration
+	}{
+		{"tagged version behind", "example.com/lib", "v1.0.0", "v1.1.1", 2, 181 * 24 * time.Hour},
+		{"latest release", "example.com/lib", "v1.1.1", "v1.1.1", 0, 0},
+		// Only releases tagged after the commit count, even though v1.0.1 sorts after v1.0.0
+		{"pseudo-version between releases", "example.com/lib", "v1.0.1-0.20220315000000-abcdefabcdef", "v1.1.1", 2, 108 * 24 * time.Hour},
+		{"pseudo-version after latest", "example.com/lib", "v1.1.2-0.20220710000000-abcdefabcdef", "v1.1.1", 0, 0},
+		{"prerelease in use", "example.com/lib", "v1.2.0-beta.1", "v1.2.0-rc.1", 1, 17 * 24 * time.Hour},
+		{"incompatible versions", "example.com/old", "v1.5.0", "v3.1.0+incompatible", 2, 365 * 24 * time.Hour},
+		{"no tagged versions", "example.com/untagged", "v0.0.0-20220101000000-abcdefabcdef", "", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lag, err := r.GetVersionLag(context.Background(), tt.module, tt.version)
+			if err != nil {
+				t.Fatalf("GetVersionLag() error = %v", err)
+			}
+			if lag.Latest != tt.wantLatest || lag.ReleasesBehind != tt.wantBehind {
+				t.Errorf("Latest = %q, ReleasesBehind = %d; want %q, %d", lag.Latest, lag.ReleasesBehind, tt.wantLatest, tt.wantBehind)
+			}
+			if lag.AgeGap != tt.wantGap {
+				t.Errorf("AgeGap = %v, want %v", lag.AgeGap, tt.wantGap)
+			}
+		})
+	}
+}
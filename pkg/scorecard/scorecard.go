@@ -0,0 +1,139 @@
+// Package scorecard reads OpenSSF Scorecard results from local JSON files, so that scores
+// can be attached to dependencies without network access.
+package scorecard
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// MaintainedCheck is the Scorecard check scoring recent commit and issue activity
+const MaintainedCheck = "Maintained"
+
+// Score is a repository's Scorecard result. Scores range from 0 to 10; a check that could
+// not be evaluated scores -1.
+type Score struct {
+	Repo string
+	// Date is when Scorecard ran, as recorded in the result
+	Date       string
+	Aggregate  float64
+	Maintained float64
+}
+
+// Store holds Scorecard results keyed by repository, e.g. "github.com/ossf/scorecard"
+type Store struct {
+	scores map[string]*Score
+}
+
+// result is the part of Scorecard's JSON output (--format json) used here
+type result struct {
+	Date string `json:"date"`
+	Repo struct {
+		Name string `json:"name"`
+	} `json:"repo"`
+	Score  float64 `json:"score"`
+	Checks []struct {
+		Name  string  `json:"name"`
+		Score float64 `json:"score"`
+	} `json:"checks"`
+}
+
+// Load reads Scorecard results from path, a JSON file or a directory of them. A file may hold
+// a single result, an array of results or one result per line. When a repository appears
+// more than once, its newest result is kept.
+func Load(path string) (*Store, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Scorecard results: %w", err)
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		files, err = filepath.Glob(filepath.Join(path, "*.json"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Scorecard results: %w", err)
+		}
+	}
+
+	store := &Store{scores: make(map[string]*Score)}
+	for _, file := range files {
+		if err := store.loadFile(file); err != nil {
+			return nil, err
+		}
+	}
+	return store, nil
+}
+
+// loadFile adds the results in one file to the store
+func (s *Store) loadFile(path string) error {
+	f, err := os.Open(path) //nolint:gosec // G304: the results path is configured by the user
+	if err != nil {
+		return fmt.Errorf("failed to read Scorecard results: %w", err)
+	}
+	defer f.Close()
+
+	decoder := json.NewDecoder(f)
+	for {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to parse Scorecard results in %s: %w", path, err)
+		}
+
+		var results []result
+		if strings.HasPrefix(strings.TrimSpace(string(raw)), "[") {
+			err = json.Unmarshal(raw, &results)
+		} else {
+			results = make([]result, 1)
+			err = json.Unmarshal(raw, &results[0])
+		}
+		if err != nil {
+			return fmt.Errorf("failed to parse Scorecard results in %s: %w", path, err)
+		}
+		for i := range results {
+			s.add(&results[i])
+		}
+	}
+}
+
+// add keeps r unless a newer result for its repository is already stored
+func (s *Store) add(r *result) {
+	if r.Repo.Name == "" {
+		return
+	}
+
+	score := &Score{Repo: r.Repo.Name, Date: r.Date, Aggregate: r.Score, Maintained: -1}
+	for _, check := range r.Checks {
+		if check.Name == MaintainedCheck {
+			score.Maintained = check.Score
+		}
+	}
+
+	key := repoKey(r.Repo.Name)
+	// Scorecard dates are ISO 8601, so they order as strings
+	if existing, ok := s.scores[key]; ok && existing.Date > score.Date {
+		return
+	}
+	s.scores[key] = score
+}
+
+// Lookup returns the Scorecard result for repo, given as host/owner/repo or as a URL
+func (s *Store) Lookup(repo string) (*Score, bool) {
+	score, ok := s.scores[repoKey(repo)]
+	return score, ok
+}
+
+// repoKey normalizes a repository name or URL to lower-case host/owner/repo
+func repoKey(repo string) string {
+	repo = strings.ToLower(repo)
+	if i := strings.Index(repo, "://"); i >= 0 {
+		repo = repo[i+3:]
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(repo, "/"), ".git")
+}
@@ -0,0 +1,80 @@
+package scorecard
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// scorecardJSON renders a Scorecard result with a Maintained check
+func scorecardJSON(repo, date string, score, maintained float64) string {
+	return fmt.Sprintf(`{"date":%q,"repo":{"name":%q,"commit":"abc"},"score":%v,"checks":[{"name":"Code-Review","score":3},{"name":"Maintained","score":%v}]}`,
+		date, repo, score, maintained)
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		// One result per line, as written by scripted runs
+		"lines.json": scorecardJSON("github.com/org/dead", "2024-01-01", 3.2, 0) + "\n" +
+			scorecardJSON("github.com/org/alive", "2024-01-01", 7.5, 10) + "\n",
+		// An array, with a newer result for a repository seen above
+		"array.json": "[" + scorecardJSON("github.com/org/dead", "2024-06-01", 2.9, -1) + "," +
+			`{"date":"2024-06-01","repo":{"name":"github.com/org/nochecks"},"score":5}` + "]",
+		"notes.txt": "not a result",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	store, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+
+	tests := []struct {
+		repo           string
+		wantAggregate  float64
+		wantMaintained float64
+		wantFound      bool
+	}{
+		{"github.com/org/dead", 2.9, -1, true},
+		{"https://github.com/Org/Alive", 7.5, 10, true},
+		{"github.com/org/nochecks", 5, -1, true},
+		{"github.com/org/unknown", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.repo, func(t *testing.T) {
+			score, found := store.Lookup(tt.repo)
+			if found != tt.wantFound {
+				t.Fatalf("Lookup() found = %v, want %v", found, tt.wantFound)
+			}
+			if found && (score.Aggregate != tt.wantAggregate || score.Maintained != tt.wantMaintained) {
+				t.Errorf("Lookup() = %+v, want aggregate %v, maintained %v", score, tt.wantAggregate, tt.wantMaintained)
+			}
+		})
+	}
+}
+
+func TestLoad_SingleFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "result.json")
+	if err := os.WriteFile(path, []byte(scorecardJSON("github.com/org/repo", "2024-01-01", 6, 8)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if score, ok := store.Lookup("github.com/org/repo"); !ok || score.Maintained != 8 {
+		t.Errorf("Lookup() = %+v, %v; want Maintained 8", score, ok)
+	}
+
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Load() accepted a missing path")
+	}
+}
@@ -1,32 +1,193 @@
 package types
 
-import "time"
+import (
+	"fmt"
+	"slices"
+	"time"
+)
 
 // RepoInfo holds repository information in a hosting-provider-agnostic format.
 // It is used by analyzer, cache, formatter, and providers packages.
 type RepoInfo struct {
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-	LastCommitAt  *time.Time
-	Description   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// PushedAt is the last push to any branch (GitHub only)
+	PushedAt *time.Time
+	// LastCommitAt is the date of the newest commit on the default branch
+	LastCommitAt *time.Time
+	// LastHumanCommitAt is the newest default-branch commit not authored by a bot (GitHub only)
+	LastHumanCommitAt *time.Time
+	// LatestReleaseAt is when LatestRelease was published; nil if there is no release
+	LatestReleaseAt *time.Time
+	LatestRelease   string
+	// License is the SPDX identifier of the license the host detects on the default branch:
+	// NoLicense if there is none, and "NOASSERTION" if it could not be identified. GitHub and
+	// GitLab report licenses; other hosts leave it empty.
+	License string
+	// Topics are the repository's tags, e.g. "deprecated" (GitHub, GitLab)
+	Topics []string
+	// Contributors lists human default-branch committers, most commits first (GitHub only)
+	Contributors []Contributor
+	// Parent is the repository this one was forked from; nil unless IsFork (GitHub only)
+	Parent *ForkParent
+	// FullName is the owner/repo path the host reports; after a rename or transfer
+	// it differs from the path that was requested
+	FullName    string
+	Description string
+	// ReadmeHeader is the beginning of the README, where maintenance banners go (GitHub only)
+	ReadmeHeader  string
 	DefaultBranch string
 	URL           string
 	IsArchived    bool
+	IsFork        bool
 	Exists        bool
 }
 
+// NoLicense is the License of a repository with no license file. Without a license, nobody
+// may fork and maintain the code.
+const NoLicense = "NONE"
+
+// ForkParent describes the repository a fork was created from
+type ForkParent struct {
+	PushedAt *time.Time
+	FullName string
+	// AheadBy and BehindBy count commits on the fork's default branch that are
+	// not in the parent's, and the reverse
+	AheadBy    int
+	BehindBy   int
+	IsArchived bool
+}
+
+// ModuleActivity describes a module nested in a subdirectory of its repository, e.g.
+// github.com/org/repo/sub/v2, whose activity can differ from the repository's as a whole
+type ModuleActivity struct {
+	// LastCommitAt and LastHumanCommitAt are the newest default-branch commits touching Dir
+	LastCommitAt      *time.Time
+	LastHumanCommitAt *time.Time
+	// LatestReleaseAt is when LatestRelease, a Dir/vX.Y.Z tag, was published
+	LatestReleaseAt *time.Time
+	Dir             string
+	LatestRelease   string
+}
+
+// LatestActivity returns the module's newest commit and which signal it came from. Pushes
+// and metadata updates are repository-wide, so only the commit signals apply; the newest
+// human commit is used when signals select it without last_commit.
+func (m *ModuleActivity) LatestActivity(signals []ActivitySignal) (time.Time, ActivitySignal, bool) {
+	if m.LastHumanCommitAt != nil && slices.Contains(signals, ActivityLastHumanCommit) && !slices.Contains(signals, ActivityLastCommit) {
+		return *m.LastHumanCommitAt, ActivityLastHumanCommit, true
+	}
+	if m.LastCommitAt != nil {
+		return *m.LastCommitAt, ActivityLastCommit, true
+	}
+	return time.Time{}, "", false
+}
+
+// Contributor summarizes one author's commits to the default branch
+type Contributor struct {
+	// LastCommitAt is the start of the most recent week with a commit by this author
+	LastCommitAt *time.Time
+	Login        string
+	Commits      int
+}
+
+// ActiveCommitters counts contributors with a commit since the given time
+func (info *RepoInfo) ActiveCommitters(since time.Time) int {
+	active := 0
+	for _, contributor := range info.Contributors {
+		if contributor.LastCommitAt != nil && !contributor.LastCommitAt.Before(since) {
+			active++
+		}
+	}
+	return active
+}
+
+// TopContributor returns the contributor with the most commits and their share of all
+// commits. ok is false if there is no contributor data.
+func (info *RepoInfo) TopContributor() (top Contributor, share float64, ok bool) {
+	total := 0
+	for _, contributor := range info.Contributors {
+		total += contributor.Commits
+		if contributor.Commits > top.Commits {
+			top = contributor
+		}
+	}
+	if total == 0 {
+		return Contributor{}, 0, false
+	}
+	return top, float64(top.Commits) / float64(total), true
+}
+
+// ActivitySignal names a RepoInfo timestamp that can count as repository activity
+type ActivitySignal string
+
+const (
+	ActivityLastCommit      ActivitySignal = "last_commit"
+	ActivityLastHumanCommit ActivitySignal = "last_human_commit"
+	ActivityPushed          ActivitySignal = "pushed_at"
+	ActivityUpdated         ActivitySignal = "updated_at"
+)
+
+// DefaultActivitySignals are the timestamps that count as activity unless configured otherwise.
+// GitHub bumps updated_at on stars and metadata edits, so it is not included.
+var DefaultActivitySignals = []ActivitySignal{ActivityLastCommit, ActivityPushed}
+
+// allActivitySignals lists every signal, in the order used to break ties
+var allActivitySignals = []ActivitySignal{ActivityLastHumanCommit, ActivityLastCommit, ActivityPushed, ActivityUpdated}
+
+// ParseActivitySignal validates an activity signal name
+func ParseActivitySignal(name string) (ActivitySignal, error) {
+	for _, signal := range allActivitySignals {
+		if string(signal) == name {
+			return signal, nil
+		}
+	}
+	return "", fmt.Errorf("unknown activity signal %q (valid: last_commit, last_human_commit, pushed_at, updated_at)", name)
+}
+
+// ActivityTime returns the timestamp for a signal, or false if the provider did not report it
+func (info *RepoInfo) ActivityTime(signal ActivitySignal) (time.Time, bool) {
+	var t *time.Time
+	switch signal {
+	case ActivityLastCommit:
+		t = info.LastCommitAt
+	case ActivityLastHumanCommit:
+		t = info.LastHumanCommitAt
+	case ActivityPushed:
+		t = info.PushedAt
+	case ActivityUpdated:
+		t = &info.UpdatedAt
+	}
+
+	if t == nil || t.IsZero() {
+		return time.Time{}, false
+	}
+	return *t, true
+}
+
+// LatestActivity returns the most recent of the given signals and which signal it came from.
+// Signals left out are never used: if none of the given signals is available, as when a
+// provider does not report them or a request failed, the source is empty and the activity
+// unknown.
+func (info *RepoInfo) LatestActivity(signals []ActivitySignal) (time.Time, ActivitySignal) {
+	var latest time.Time
+	var source ActivitySignal
+	for _, signal := range signals {
+		if t, ok := info.ActivityTime(signal); ok && t.After(latest) {
+			latest, source = t, signal
+		}
+	}
+	return latest, source
+}
+
 // IsRepositoryActive checks if a repository has been active within the given duration.
-// It uses the latest of UpdatedAt or LastCommitAt.
+// It uses the latest of UpdatedAt or LastCommitAt; use LatestActivity to choose the signals.
 func (info *RepoInfo) IsRepositoryActive(maxAge time.Duration) bool {
 	if !info.Exists {
 		return false
 	}
 
-	latestActivity := info.UpdatedAt
-	if info.LastCommitAt != nil && info.LastCommitAt.After(info.UpdatedAt) {
-		latestActivity = *info.LastCommitAt
-	}
-
+	latestActivity, _ := info.LatestActivity([]ActivitySignal{ActivityLastCommit, ActivityUpdated})
 	return time.Since(latestActivity) <= maxAge
 }
 
@@ -37,10 +198,6 @@ func (info *RepoInfo) DaysSinceLastActivity() int {
 		return -1
 	}
 
-	latestActivity := info.UpdatedAt
-	if info.LastCommitAt != nil && info.LastCommitAt.After(info.UpdatedAt) {
-		latestActivity = *info.LastCommitAt
-	}
-
+	latestActivity, _ := info.LatestActivity([]ActivitySignal{ActivityLastCommit, ActivityUpdated})
 	return int(time.Since(latestActivity).Hours() / 24)
 }
@@ -0,0 +1,20 @@
+package types
+
+import "time"
+
+// Responsiveness summarizes how quickly maintainers respond to recent issues and pull
+// requests opened by other people.
+type Responsiveness struct {
+	// MedianResponse is the median time to first maintainer response; items still
+	// waiting for one count with their age so far
+	MedianResponse time.Duration
+	Sampled        int
+	Responded      int
+	Open           int
+	Closed         int
+}
+
+// MedianResponseDays returns MedianResponse in whole days
+func (r *Responsiveness) MedianResponseDays() int {
+	return int(r.MedianResponse.Hours() / 24)
+}
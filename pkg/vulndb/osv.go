@@ -0,0 +1,102 @@
+package vulndb
+
+import (
+	"slices"
+
+	"golang.org/x/mod/semver"
+)
+
+// entry is the part of an OSV advisory used here, see https://ossf.github.io/osv-schema/
+type entry struct {
+	ID               string     `json:"id"`
+	Summary          string     `json:"summary"`
+	Aliases          []string   `json:"aliases"`
+	Affected         []affected `json:"affected"`
+	DatabaseSpecific struct {
+		URL string `json:"url"`
+	} `json:"database_specific"`
+}
+
+type affected struct {
+	Package struct {
+		Name      string `json:"name"`
+		Ecosystem string `json:"ecosystem"`
+	} `json:"package"`
+	Ranges []affectedRange `json:"ranges"`
+}
+
+type affectedRange struct {
+	Type   string  `json:"type"`
+	Events []event `json:"events"`
+}
+
+// event opens (Introduced) or closes (Fixed) a range of affected versions. Versions in the
+// Go database are semver without the "v" prefix, and "0" means the first version.
+type event struct {
+	Introduced string `json:"introduced,omitempty"`
+	Fixed      string `json:"fixed,omitempty"`
+}
+
+// affects reports whether version of modulePath is affected by the advisory, and the version
+// that fixes it, or "" if no fix is released
+func (e *entry) affects(modulePath, version string) (string, bool) {
+	for _, a := range e.Affected {
+		if a.Package.Name != modulePath || a.Package.Ecosystem != "Go" {
+			continue
+		}
+		if len(a.Ranges) == 0 {
+			// No ranges means every version is affected
+			return "", true
+		}
+		for _, r := range a.Ranges {
+			if r.Type != "SEMVER" {
+				continue
+			}
+			if fixed, ok := r.contains(version); ok {
+				return fixed, true
+			}
+		}
+	}
+	return "", false
+}
+
+// contains reports whether version falls in one of the range's [introduced, fixed) intervals,
+// and the fixed version closing that interval
+func (r affectedRange) contains(version string) (string, bool) {
+	events := slices.Clone(r.Events)
+	slices.SortStableFunc(events, func(a, b event) int {
+		return semver.Compare(a.version(), b.version())
+	})
+
+	introduced := ""
+	for _, ev := range events {
+		switch {
+		case ev.Introduced != "":
+			if introduced == "" {
+				introduced = ev.version()
+			}
+		case ev.Fixed != "" && introduced != "":
+			if semver.Compare(version, introduced) >= 0 && semver.Compare(version, ev.version()) < 0 {
+				return ev.version(), true
+			}
+			introduced = ""
+		}
+	}
+	if introduced != "" && semver.Compare(version, introduced) >= 0 {
+		return "", true
+	}
+	return "", false
+}
+
+// version returns the event's version in Go's "v"-prefixed form
+func (ev event) version() string {
+	v := ev.Introduced
+	if v == "" {
+		v = ev.Fixed
+	}
+	if v == "0" {
+		// Below every version, pseudo-versions of v0.0.0 included
+		return "v0.0.0-0"
+	}
+	return "v" + v
+}
@@ -0,0 +1,118 @@
+// Package vulndb reads a local copy of the Go vulnerability database, in the OSV JSON
+// layout served by vuln.go.dev, and matches its advisories to module versions.
+package vulndb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// modulesIndex is the database's index of advisories by module, index/modules.json
+const modulesIndex = "index/modules.json"
+
+// Vulnerability is an advisory affecting a module version
+type Vulnerability struct {
+	ID      string
+	Summary string
+	Aliases []string
+	// FixedVersion is the first version without the vulnerability; empty if no fix is released
+	FixedVersion string
+	URL          string
+}
+
+// Fixed reports whether a release fixing the vulnerability exists
+func (v Vulnerability) Fixed() bool {
+	return v.FixedVersion != ""
+}
+
+// DB is a Go vulnerability database on disk. Advisories are read on first use and kept.
+type DB struct {
+	dir     string
+	modules map[string][]string // module path -> advisory IDs
+
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+// Open reads the module index of the database in dir. A file:// URL, as accepted by
+// GOVULNDB, names its directory.
+func Open(dir string) (*DB, error) {
+	dir = strings.TrimPrefix(dir, "file://")
+
+	data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(modulesIndex))) //nolint:gosec // G304: the database directory is configured by the user
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%s is not a Go vulnerability database: missing %s", dir, modulesIndex)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vulnerability database: %w", err)
+	}
+
+	var index []struct {
+		Path  string `json:"path"`
+		Vulns []struct {
+			ID string `json:"id"`
+		} `json:"vulns"`
+	}
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", modulesIndex, err)
+	}
+
+	db := &DB{dir: dir, modules: make(map[string][]string, len(index)), entries: make(map[string]*entry)}
+	for _, mod := range index {
+		for _, vuln := range mod.Vulns {
+			db.modules[mod.Path] = append(db.modules[mod.Path], vuln.ID)
+		}
+	}
+	return db, nil
+}
+
+// Lookup returns the advisories affecting version of modulePath
+func (db *DB) Lookup(modulePath, version string) ([]Vulnerability, error) {
+	var vulns []Vulnerability
+	for _, id := range db.modules[modulePath] {
+		e, err := db.entry(id)
+		if err != nil {
+			return nil, err
+		}
+		if fixed, ok := e.affects(modulePath, version); ok {
+			vulns = append(vulns, Vulnerability{
+				ID:           e.ID,
+				Summary:      e.Summary,
+				Aliases:      e.Aliases,
+				FixedVersion: fixed,
+				URL:          e.DatabaseSpecific.URL,
+			})
+		}
+	}
+	return vulns, nil
+}
+
+// entry reads and caches the OSV entry for an advisory
+func (db *DB) entry(id string) (*entry, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if e, ok := db.entries[id]; ok {
+		return e, nil
+	}
+	if strings.ContainsAny(id, `/\`) {
+		return nil, fmt.Errorf("invalid advisory ID %q", id)
+	}
+
+	data, err := os.ReadFile(filepath.Join(db.dir, "ID", id+".json")) //nolint:gosec // G304: the database directory is configured by the user
+	if err != nil {
+		return nil, fmt.Errorf("failed to read advisory %s: %w", id, err)
+	}
+	e := &entry{}
+	if err := json.Unmarshal(data, e); err != nil {
+		return nil, fmt.Errorf("failed to parse advisory %s: %w", id, err)
+	}
+	db.entries[id] = e
+	return e, nil
+}
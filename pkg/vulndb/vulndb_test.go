@@ -0,0 +1,82 @@
+package vulndb
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// newTestDB lays out a vulnerability database with the given advisories in a temporary directory
+func newTestDB(t *testing.T, modules string, advisories map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "index", "modules.json"), modules)
+	for id, content := range advisories {
+		writeTestFile(t, filepath.Join(dir, "ID", id+".json"), content)
+	}
+	return dir
+}
+
+func writeTestFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	dir := newTestDB(t,
+		`[{"path":"example.com/lib","vulns":[{"id":"GO-2024-0001"},{"id":"GO-2024-0002"}]}]`,
+		map[string]string{
+			"GO-2024-0001": `{"id":"GO-2024-0001","summary":"Panic on malformed input","aliases":["CVE-2024-1111"],
+				"affected":[{"package":{"name":"example.com/lib","ecosystem":"Go"},"ranges":[{"type":"SEMVER","events":[{"introduced":"0"},{"fixed":"1.2.0"},{"introduced":"1.3.0"},{"fixed":"1.3.4"}]}]}],
+				"database_specific":{"url":"https://pkg.go.dev/vuln/GO-2024-0001"}}`,
+			"GO-2024-0002": `{"id":"GO-2024-0002","summary":"Path traversal",
+				"affected":[{"package":{"name":"example.com/lib","ecosystem":"Go"},"ranges":[{"type":"SEMVER","events":[{"introduced":"1.1.0"}]}]}]}`,
+		})
+
+	db, err := Open("file://" + dir)
+	if err != nil {
+		t.Fatalf("Open() error: %v", err)
+	}
+
+	tests := []struct {
+		module    string
+		version   string
+		wantIDs   []string
+		wantFixed []string
+	}{
+		{"example.com/lib", "v1.0.0", []string{"GO-2024-0001"}, []string{"v1.2.0"}},
+		{"example.com/lib", "v0.0.0-20200101000000-abcdefabcdef", []string{"GO-2024-0001"}, []string{"v1.2.0"}},
+		{"example.com/lib", "v1.1.5", []string{"GO-2024-0001", "GO-2024-0002"}, []string{"v1.2.0", ""}},
+		{"example.com/lib", "v1.2.0", []string{"GO-2024-0002"}, []string{""}},
+		{"example.com/lib", "v1.3.1", []string{"GO-2024-0001", "GO-2024-0002"}, []string{"v1.3.4", ""}},
+		{"example.com/other", "v1.0.0", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.module+"@"+tt.version, func(t *testing.T) {
+			vulns, err := db.Lookup(tt.module, tt.version)
+			if err != nil {
+				t.Fatalf("Lookup() error: %v", err)
+			}
+			if len(vulns) != len(tt.wantIDs) {
+				t.Fatalf("Lookup() = %+v, want %v", vulns, tt.wantIDs)
+			}
+			for i, vuln := range vulns {
+				if vuln.ID != tt.wantIDs[i] || vuln.FixedVersion != tt.wantFixed[i] {
+					t.Errorf("vulns[%d] = %s fixed in %q, want %s fixed in %q", i, vuln.ID, vuln.FixedVersion, tt.wantIDs[i], tt.wantFixed[i])
+				}
+			}
+		})
+	}
+}
+
+func TestOpen_NotADatabase(t *testing.T) {
+	if _, err := Open(t.TempDir()); err == nil {
+		t.Error("Open() accepted a directory without a module index")
+	}
+}